@@ -0,0 +1,163 @@
+// pinyin-dictgen 生成内置词典, 由 pinyin 包的 go generate 调用
+//
+// 将文本格式的词典和本地修改编译为内置词典使用的二进制格式:
+//
+//	pinyin-dictgen -overrides data/overrides.txt -o dict.bin data/dict.txt
+//
+// 指定 -overtrue 时先从 overtrue/pinyin 的数据目录 (如 pinyin/data) 导入词典, 写入文本格式的词典文件, 再编译:
+//
+//	pinyin-dictgen -overtrue ~/src/overtrue-pinyin/data -overrides data/overrides.txt -o dict.bin data/dict.txt
+//
+// 指定 -normalize 时按导入的规则检查并规范词典文件中的音节, 写回词典文件后再编译
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"unicode/utf8"
+
+	"pinyin-golang/internal/dictfile"
+)
+
+// config 命令行参数
+type config struct {
+	out       string
+	overtrue  string
+	overrides string
+	normalize bool
+	strict    bool
+}
+
+func main() {
+	var c config
+	flag.StringVar(&c.out, "o", "dict.bin", "输出的二进制词典")
+	flag.StringVar(&c.overtrue, "overtrue", "", "overtrue/pinyin 的数据目录, 包含 words* 和 chars* 文件, 导入后写入词典文件")
+	flag.StringVar(&c.overrides, "overrides", "", "本地修改, 格式与词典文件相同, 优先于词典, 读音为 - 时删除词语")
+	flag.BoolVar(&c.normalize, "normalize", false, "按导入的规则规范词典文件中的音节, 如 lu:4 → lv4, 写回词典文件")
+	flag.BoolVar(&c.strict, "strict", false, "导入时有不合法的词语则失败, 默认跳过并输出警告")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "用法: %s [参数] 词典文件 ...\n多个文件按顺序合并, 排在前面的词语优先匹配; 指定 -overtrue 或 -normalize 时只能有一个词典文件\n\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 || (c.overtrue != "" || c.normalize) && flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(c, flag.Args()); err != nil {
+		fmt.Fprintln(os.Stderr, "pinyin-dictgen:", err)
+		os.Exit(1)
+	}
+}
+
+// run 导入并编译词典
+func run(c config, files []string) error {
+	if c.overtrue != "" {
+		if err := importOvertrue(c, files[0]); err != nil {
+			return err
+		}
+	} else if c.normalize {
+		if err := normalizeText(c, files[0]); err != nil {
+			return err
+		}
+	}
+
+	var entries []dictfile.Entry
+	for _, name := range files {
+		e, err := readText(name)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, e...)
+	}
+	var overrides []dictfile.Entry
+	if c.overrides != "" {
+		var err error
+		if overrides, err = readText(c.overrides); err != nil {
+			return err
+		}
+	}
+
+	data, err := dictfile.Encode(dictfile.Merge(entries, overrides))
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(c.out, data, 0644)
+}
+
+// importOvertrue 从 overtrue/pinyin 的数据导入词典, 按字数从多到少排列并去重后写入 name
+func importOvertrue(c config, name string) error {
+	entries, problems, err := readOvertrue(c.overtrue)
+	if err != nil {
+		return err
+	}
+	return writeImported(c, name, []string{
+		"内置词典, 每行一个词语和带数字声调的读音, 排在前面的词语优先匹配",
+		"由 pinyin-dictgen 从 https://github.com/overtrue/pinyin/blob/master/data/ 导入, 不要直接修改",
+		"本地修改写在 overrides.txt 中, 修改后运行 go generate 重新生成 dict.bin",
+	}, entries, problems)
+}
+
+// normalizeText 按导入的规则规范词典文件中的音节, 跳过不合法的词语后写回 name
+func normalizeText(c config, name string) error {
+	entries, err := readText(name)
+	if err != nil {
+		return err
+	}
+	var problems []problem
+	normalized := entries[:0]
+	for _, e := range entries {
+		syllables, msg := normalizeReading(e.Syllables)
+		if n := utf8.RuneCountInString(e.Word); msg == "" && n != len(syllables) {
+			msg = fmt.Sprintf("%d characters but %d syllables", n, len(syllables))
+		}
+		if msg != "" {
+			problems = append(problems, problem{file: filepath.Base(name), line: e.Line, word: e.Word, msg: msg})
+			continue
+		}
+		e.Syllables = syllables
+		normalized = append(normalized, e)
+	}
+	return writeImported(c, name, []string{
+		"内置词典, 每行一个词语和带数字声调的读音, 排在前面的词语优先匹配",
+		"来自之前版本的 Go 源码词典, 由 pinyin-dictgen -normalize 按导入 overtrue/pinyin 的规则规范音节, 不要直接修改",
+		"本地修改写在 overrides.txt 中, 修改后运行 go generate 重新生成 dict.bin",
+	}, normalized, problems)
+}
+
+// writeImported 报告跳过的词语, 按字数从多到少排列并去重后写入 name
+func writeImported(c config, name string, header []string, entries []dictfile.Entry, problems []problem) error {
+	for _, p := range problems {
+		fmt.Fprintln(os.Stderr, "pinyin-dictgen: skip", p)
+	}
+	if c.strict && len(problems) > 0 {
+		return fmt.Errorf("%d invalid entries", len(problems))
+	}
+
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	if err := dictfile.WriteText(f, header, dictfile.Merge(entries, nil)); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// readText 读取文本格式的词典文件
+func readText(name string) ([]dictfile.Entry, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	entries, err := dictfile.ReadText(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", name, err)
+	}
+	return entries, nil
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"pinyin-golang/internal/dictcheck"
+	"pinyin-golang/internal/dictfile"
+)
+
+// rePHPItem PHP 数组中的一项, 如 '重庆' => '	chong2	qing4', 或 "重庆" => "chóng qìng"
+var rePHPItem = regexp.MustCompile(`^\s*(['"])(.+?)['"]\s*=>\s*(['"])(.*)['"]\s*,?\s*$`)
+
+// reFileNumber 文件名中的序号, 如 words_0, words-10.php
+var reFileNumber = regexp.MustCompile(`(\d+)`)
+
+// problem 导入时跳过的词语
+type problem struct {
+	file string
+	line int
+	word string
+	msg  string
+}
+
+func (p problem) String() string {
+	return fmt.Sprintf("%s:%d: %s: %s", p.file, p.line, p.word, p.msg)
+}
+
+// overtrueFiles 返回目录中的词语文件和单字文件, 词语文件按序号排列, 单字文件排在最后
+func overtrueFiles(dir string) ([]string, error) {
+	var words, chars []string
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		switch name := e.Name(); {
+		case e.IsDir():
+		case strings.HasPrefix(name, "words"):
+			words = append(words, filepath.Join(dir, name))
+		case strings.HasPrefix(name, "chars"):
+			chars = append(chars, filepath.Join(dir, name))
+		}
+	}
+	if len(words) == 0 && len(chars) == 0 {
+		return nil, fmt.Errorf("%s: no words or chars files", dir)
+	}
+	sort.Slice(words, func(i, j int) bool { return fileNumber(words[i]) < fileNumber(words[j]) })
+	sort.Strings(chars)
+	return append(words, chars...), nil
+}
+
+// fileNumber 文件名中的序号, 没有序号时为 0
+func fileNumber(name string) int {
+	n, _ := strconv.Atoi(reFileNumber.FindString(filepath.Base(name)))
+	return n
+}
+
+// readOvertrue 读取 overtrue/pinyin 的数据文件, 音节转换为带数字声调的写法
+// 不合法的音节和字数与音节数不同的词语被跳过, 返回跳过的原因
+func readOvertrue(dir string) ([]dictfile.Entry, []problem, error) {
+	files, err := overtrueFiles(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	var entries []dictfile.Entry
+	var problems []problem
+	for _, name := range files {
+		n := len(entries)
+		entries, problems, err = readPHPFile(name, entries, problems)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(entries) == n {
+			return nil, nil, fmt.Errorf("%s: no entries", name)
+		}
+	}
+	return entries, problems, nil
+}
+
+// readPHPFile 读取一个 PHP 数组文件
+func readPHPFile(name string, entries []dictfile.Entry, problems []problem) ([]dictfile.Entry, []problem, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	file := filepath.Base(name)
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		m := rePHPItem.FindStringSubmatch(scanner.Text())
+		if m == nil {
+			continue
+		}
+		word, reading := m[2], m[4]
+		if m[3] == `"` {
+			reading = strings.NewReplacer(`\t`, "\t", `\n`, "\n", `\"`, `"`, `\\`, `\`).Replace(reading)
+		}
+		syllables, msg := normalizeReading(strings.Fields(reading))
+		if msg == "" && utf8.RuneCountInString(word) == 1 && len(syllables) > 1 {
+			// 单字文件中的多音字, 第一个读音最常用
+			syllables = syllables[:1]
+		}
+		if n := utf8.RuneCountInString(word); msg == "" && n != len(syllables) {
+			msg = fmt.Sprintf("%d characters but %d syllables", n, len(syllables))
+		}
+		if msg != "" {
+			problems = append(problems, problem{file: file, line: line, word: word, msg: msg})
+			continue
+		}
+		entries = append(entries, dictfile.Entry{Word: word, Syllables: syllables, Line: line})
+	}
+	return entries, problems, scanner.Err()
+}
+
+// normalizeReading 检查每个音节, 统一为带数字声调, ü 写作 v 的写法, 如 lǜ → lv4, lüè → lue4
+func normalizeReading(tokens []string) ([]string, string) {
+	if len(tokens) == 0 {
+		return nil, "missing reading"
+	}
+	syllables := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		syllable, ok := dictcheck.Normalize(token)
+		if !ok {
+			return nil, fmt.Sprintf("invalid syllable %q", token)
+		}
+		syllables = append(syllables, syllable)
+	}
+	return syllables, ""
+}
@@ -0,0 +1,200 @@
+// pinyin-dictlint 检查内置词典和姓名读音表中的读音, 按文件和行号输出问题, 在仓库的根目录运行:
+//
+//	pinyin-dictlint
+//	pinyin-dictlint -disable reading,erhua -json > issues.jsonl
+//
+// 有 error 级别的问题时退出码为 1, 指定 -strict 时 warning 也视为错误
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"pinyin-golang/internal/dictcheck"
+	"pinyin-golang/internal/dictfile"
+)
+
+// config 命令行参数
+type config struct {
+	dict      string
+	overrides string
+	names     string
+	disable   string
+	json      bool
+	strict    bool
+}
+
+func main() {
+	var c config
+	flag.StringVar(&c.dict, "dict", "pinyin/data/dict.txt", "内置词典")
+	flag.StringVar(&c.overrides, "overrides", "pinyin/data/overrides.txt", "内置词典的本地修改, 为空时不检查")
+	flag.StringVar(&c.names, "names", "pinyin/dict.go", "包含姓名读音表 (词语, 读音 交替排列的 []string) 的 Go 源文件, 为空时不检查")
+	flag.StringVar(&c.disable, "disable", "", "跳过的检查, 以逗号分隔")
+	flag.BoolVar(&c.json, "json", false, "每行输出一个 JSON 对象: file, line, word, check, severity, message")
+	flag.BoolVar(&c.strict, "strict", false, "有 warning 级别的问题时退出码也为 1")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "用法: %s [参数]\n\n", os.Args[0])
+		flag.PrintDefaults()
+		fmt.Fprintf(flag.CommandLine.Output(), "\n检查:\n")
+		names := make([]string, 0, len(dictcheck.Checks))
+		for name := range dictcheck.Checks {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Fprintf(flag.CommandLine.Output(), "  %-10s %s\n", name, dictcheck.Checks[name])
+		}
+	}
+	flag.Parse()
+
+	failed, err := run(c, os.Stdout)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "pinyin-dictlint:", err)
+		os.Exit(2)
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// run 检查并输出问题, 返回是否有导致失败的问题
+func run(c config, w io.Writer) (bool, error) {
+	disabled := map[string]bool{}
+	for _, name := range strings.Split(c.disable, ",") {
+		if name = strings.TrimSpace(name); name == "" {
+			continue
+		}
+		if _, ok := dictcheck.Checks[name]; !ok {
+			return false, fmt.Errorf("unknown check %q", name)
+		}
+		disabled[name] = true
+	}
+
+	var sources []dictcheck.Source
+	entries, err := readText(c.dict)
+	if err != nil {
+		return false, err
+	}
+	sources = append(sources, dictcheck.Source{File: c.dict, Kind: dictcheck.Dict, Entries: entries})
+	if c.overrides != "" {
+		if entries, err = readText(c.overrides); err != nil {
+			return false, err
+		}
+		sources = append(sources, dictcheck.Source{File: c.overrides, Kind: dictcheck.Overrides, Entries: entries})
+	}
+	if c.names != "" {
+		tables, err := readGoTables(c.names)
+		if err != nil {
+			return false, err
+		}
+		for _, entries := range tables {
+			sources = append(sources, dictcheck.Source{File: c.names, Kind: dictcheck.Names, Entries: entries})
+		}
+	}
+
+	issues := dictcheck.Check(sources, disabled)
+	failed := false
+	counts := map[string]int{}
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	for _, issue := range issues {
+		counts[issue.Severity]++
+		failed = failed || issue.Severity == dictcheck.Error || c.strict
+		if c.json {
+			if err := enc.Encode(issue); err != nil {
+				return false, err
+			}
+		} else {
+			fmt.Fprintln(w, issue)
+		}
+	}
+	if !c.json {
+		fmt.Fprintf(os.Stderr, "%d errors, %d warnings\n", counts[dictcheck.Error], counts[dictcheck.Warning])
+	}
+	return failed, nil
+}
+
+// readText 读取文本格式的词典文件, 带有行号
+func readText(name string) ([]dictfile.Entry, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	entries, err := dictfile.ReadText(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", name, err)
+	}
+	return entries, nil
+}
+
+// readGoTables 读取 Go 源文件中 词语, 读音 交替排列的 []string 变量, 每个变量为一个读音表
+func readGoTables(name string) ([][]dictfile.Entry, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, name, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	var tables [][]dictfile.Entry
+	ast.Inspect(file, func(n ast.Node) bool {
+		spec, ok := n.(*ast.ValueSpec)
+		if !ok {
+			return true
+		}
+		for _, value := range spec.Values {
+			lit, ok := value.(*ast.CompositeLit)
+			if !ok || !isStringSlice(lit.Type) || len(lit.Elts)%2 != 0 {
+				continue
+			}
+			var entries []dictfile.Entry
+			for i := 0; i < len(lit.Elts); i += 2 {
+				word, ok1 := stringValue(lit.Elts[i])
+				reading, ok2 := stringValue(lit.Elts[i+1])
+				if !ok1 || !ok2 {
+					entries = nil
+					break
+				}
+				entries = append(entries, dictfile.Entry{
+					Word: word, Syllables: strings.Fields(reading), Line: fset.Position(lit.Elts[i].Pos()).Line,
+				})
+			}
+			if entries != nil {
+				tables = append(tables, entries)
+			}
+		}
+		return false
+	})
+	if tables == nil {
+		return nil, fmt.Errorf("%s: no []string tables", name)
+	}
+	return tables, nil
+}
+
+// isStringSlice 类型是否为 []string
+func isStringSlice(expr ast.Expr) bool {
+	t, ok := expr.(*ast.ArrayType)
+	if !ok || t.Len != nil {
+		return false
+	}
+	ident, ok := t.Elt.(*ast.Ident)
+	return ok && ident.Name == "string"
+}
+
+// stringValue 字符串字面量的值
+func stringValue(expr ast.Expr) (string, bool) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	s, err := strconv.Unquote(lit.Value)
+	return s, err == nil
+}
@@ -0,0 +1,163 @@
+// pinyin-enctables 生成 pinyin/encoding 使用的编码表, 由 encoding 包的 go generate 调用
+//
+// 从 WHATWG Encoding Standard (https://encoding.spec.whatwg.org/) 的编码表目录读取
+// index-gb18030.txt, index-gb18030-ranges.txt 和 index-big5.txt, 写入 Go 源文件:
+//
+//	pinyin-enctables -data ~/src/encoding -o tables.go
+//
+// 没有指定 -data 时不修改输出文件
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	// gb18030Size GB18030 双字节编码的数量, 首字节 0x81-0xFE, 尾字节 0x40-0x7E, 0x80-0xFE
+	gb18030Size = 126 * 190
+	// big5Size Big5 双字节编码的数量, 首字节 0x81-0xFE, 尾字节 0x40-0x7E, 0xA1-0xFE
+	big5Size = 126 * 157
+	// maxRangePointer GB18030 四字节编码中 BMP 字符的最大序号, 之后为 U+10000 以上的字符
+	maxRangePointer = 39419
+)
+
+// config 命令行参数
+type config struct {
+	data string
+	out  string
+}
+
+func main() {
+	var c config
+	flag.StringVar(&c.data, "data", "", "WHATWG Encoding Standard 的编码表目录, 包含 index-gb18030.txt, index-gb18030-ranges.txt 和 index-big5.txt")
+	flag.StringVar(&c.out, "o", "tables.go", "输出的 Go 源文件")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "用法: %s [参数]\n\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if c.data == "" {
+		fmt.Fprintln(os.Stderr, "pinyin-enctables: 没有指定 -data, 不修改", c.out)
+		return
+	}
+	if err := run(c); err != nil {
+		fmt.Fprintln(os.Stderr, "pinyin-enctables:", err)
+		os.Exit(1)
+	}
+}
+
+// run 读取编码表并写入 Go 源文件
+func run(c config) error {
+	gb18030, err := readIndex(filepath.Join(c.data, "index-gb18030.txt"))
+	if err != nil {
+		return err
+	}
+	ranges, err := readIndex(filepath.Join(c.data, "index-gb18030-ranges.txt"))
+	if err != nil {
+		return err
+	}
+	big5, err := readIndex(filepath.Join(c.data, "index-big5.txt"))
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by pinyin-enctables. DO NOT EDIT.\n\npackage encoding\n\nvar (\n")
+	buf.WriteString("// gb18030Table GB18030 双字节编码对应的字符, 按 (首字节-0x81)*190+尾字节的位置排列, 0 为无效编码\n")
+	writeTable(&buf, "gb18030Table", table(gb18030, gb18030Size))
+	buf.WriteString("\n// big5Table Big5 (CP950) 双字节编码对应的字符, 按 (首字节-0x81)*157+尾字节的位置排列, 0 为无效编码\n")
+	writeTable(&buf, "big5Table", table(big5, big5Size))
+	buf.WriteString("\n// gb18030Ranges GB18030 四字节编码的 BMP 字符, 每项为 {序号, 字符}, 之后的序号与字符连续对应\n")
+	buf.WriteString("gb18030Ranges = [...][2]uint16{\n")
+	for _, r := range mergeRanges(ranges) {
+		fmt.Fprintf(&buf, "{0x%04x, 0x%04x},\n", r[0], r[1])
+	}
+	buf.WriteString("}\n)\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(c.out, src, 0644)
+}
+
+// readIndex 读取 WHATWG 格式的编码表, 每行为 序号<TAB>0x编码, # 开头的行为注释
+func readIndex(name string) ([][2]int, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var index [][2]int
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Fields(text)
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("%s:%d: invalid line %q", name, line, text)
+		}
+		pointer, err := strconv.Atoi(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: invalid pointer %q", name, line, fields[0])
+		}
+		code, err := strconv.ParseInt(strings.TrimPrefix(fields[1], "0x"), 16, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: invalid code point %q", name, line, fields[1])
+		}
+		index = append(index, [2]int{pointer, int(code)})
+	}
+	return index, scanner.Err()
+}
+
+// table 按序号排列编码表, BMP 以外的字符和超出范围的序号按无效编码处理
+func table(index [][2]int, size int) []uint16 {
+	t := make([]uint16, size)
+	for _, item := range index {
+		if item[0] < size && item[1] <= 0xFFFF {
+			t[item[0]] = uint16(item[1])
+		}
+	}
+	return t
+}
+
+// mergeRanges 只保留 BMP 字符的范围, 合并序号与字符都连续的相邻范围
+func mergeRanges(index [][2]int) [][2]int {
+	var ranges [][2]int
+	for _, item := range index {
+		if item[0] > maxRangePointer || item[1] > 0xFFFF {
+			continue
+		}
+		if n := len(ranges); n > 0 && item[0]-ranges[n-1][0] == item[1]-ranges[n-1][1] {
+			continue
+		}
+		ranges = append(ranges, item)
+	}
+	return ranges
+}
+
+// writeTable 每行 16 个值写入编码表
+func writeTable(buf *bytes.Buffer, name string, t []uint16) {
+	fmt.Fprintf(buf, "%s = [...]uint16{\n", name)
+	for i, r := range t {
+		fmt.Fprintf(buf, "0x%04x,", r)
+		if i%16 == 15 || i == len(t)-1 {
+			buf.WriteString("\n")
+		} else {
+			buf.WriteString(" ")
+		}
+	}
+	buf.WriteString("}\n")
+}
@@ -0,0 +1,198 @@
+// pinyin-server 本地 HTTP/JSON 拼音转换服务, 供其他语言的服务调用
+//
+//	pinyin-server -addr 127.0.0.1:8080 -dict user.dict -watch 10s
+//	curl -d '{"texts":["重庆银行"],"tone":"ascii"}' http://127.0.0.1:8080/convert
+//
+// 用户词典在收到 SIGHUP, 调用 POST /reload 或文件修改时 (-watch) 重新读取, 读取失败时继续使用原来的词典
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"pinyin-golang/pinyin"
+)
+
+// stringsFlag 可以重复指定的参数
+type stringsFlag []string
+
+func (f *stringsFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *stringsFlag) Set(s string) error {
+	*f = append(*f, s)
+	return nil
+}
+
+// config 命令行参数
+type config struct {
+	addr     string
+	dicts    stringsFlag
+	watch    time.Duration
+	maxBody  int64
+	maxTexts int
+}
+
+func main() {
+	var c config
+	flag.StringVar(&c.addr, "addr", "127.0.0.1:8080", "监听地址")
+	flag.Var(&c.dicts, "dict", "用户词典文件, 每行一个词语和读音, 可以重复指定")
+	flag.DurationVar(&c.watch, "watch", 0, "检查用户词典是否修改的间隔, 为 0 时不检查")
+	flag.Int64Var(&c.maxBody, "max-body", 1<<20, "请求体的最大字节数")
+	flag.IntVar(&c.maxTexts, "max-texts", 1000, "每个请求最多转换的文本数")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "用法: %s [参数]\n\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if err := run(c); err != nil {
+		fmt.Fprintln(os.Stderr, "pinyin-server:", strings.TrimPrefix(err.Error(), "pinyin: "))
+		os.Exit(1)
+	}
+}
+
+// run 读取用户词典并启动服务, 收到 SIGINT 或 SIGTERM 时等待处理中的请求完成后退出
+func run(c config) error {
+	st := &store{files: c.dicts}
+	if err := st.load(); err != nil {
+		return err
+	}
+	m := newMetrics()
+	srv := &http.Server{
+		Addr:              c.addr,
+		Handler:           newServer(st, m, c.maxBody, c.maxTexts),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		for sig := range signals {
+			if sig == syscall.SIGHUP {
+				st.reload(m)
+				continue
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			srv.Shutdown(ctx)
+			cancel()
+			return
+		}
+	}()
+	if c.watch > 0 && len(c.dicts) > 0 {
+		go st.watch(c.watch, m)
+	}
+
+	log.Printf("listening on %s", c.addr)
+	if err := srv.ListenAndServe(); err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+// store 当前使用的词典, 重新读取用户词典时整体替换
+// 词典创建后只读, 可以被多个请求同时使用
+type store struct {
+	files []string
+
+	mu   sync.RWMutex
+	dict *pinyin.Dict
+	// loadedAt 词典读取的时间
+	loadedAt time.Time
+	// modTimes 读取时用户词典文件的修改时间
+	modTimes map[string]time.Time
+}
+
+// get 返回当前的词典
+func (st *store) get() *pinyin.Dict {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+	return st.dict
+}
+
+// loaded 返回词典读取的时间
+func (st *store) loaded() time.Time {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+	return st.loadedAt
+}
+
+// load 读取所有用户词典, 全部成功后替换当前的词典
+func (st *store) load() error {
+	dict := pinyin.NewDict()
+	times := map[string]time.Time{}
+	for _, name := range st.files {
+		f, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		info, err := f.Stat()
+		if err == nil {
+			times[name] = info.ModTime()
+			err = dict.LoadWords(f)
+		}
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("%s: %s", name, strings.TrimPrefix(err.Error(), "pinyin: "))
+		}
+	}
+
+	st.mu.Lock()
+	st.dict, st.loadedAt, st.modTimes = dict, time.Now(), times
+	st.mu.Unlock()
+	return nil
+}
+
+// reload 重新读取用户词典并记录结果
+func (st *store) reload(m *metrics) error {
+	err := st.load()
+	m.reloaded(err)
+	if err != nil {
+		log.Printf("reload: %s", strings.TrimPrefix(err.Error(), "pinyin: "))
+		return err
+	}
+	log.Printf("reloaded %d dictionaries", len(st.files))
+	return nil
+}
+
+// modTimes 用户词典文件的修改时间, 无法读取的文件为零值
+func modTimes(files []string) map[string]time.Time {
+	times := map[string]time.Time{}
+	for _, name := range files {
+		if info, err := os.Stat(name); err == nil {
+			times[name] = info.ModTime()
+		}
+	}
+	return times
+}
+
+// watch 每隔 interval 检查用户词典, 修改后重新读取
+// 读取失败时等待文件再次修改, 不重复报告同一个错误
+func (st *store) watch(interval time.Duration, m *metrics) {
+	st.mu.RLock()
+	last := st.modTimes
+	st.mu.RUnlock()
+	for range time.Tick(interval) {
+		current := modTimes(st.files)
+		changed := false
+		for _, name := range st.files {
+			if !current[name].Equal(last[name]) {
+				changed = true
+			}
+		}
+		if changed {
+			last = current
+			st.reload(m)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// endpointMetrics 单个接口的统计
+type endpointMetrics struct {
+	// requests 按状态码统计的请求数
+	requests map[int]int64
+	// texts 转换的文本数
+	texts int64
+	// seconds 处理请求的总时间
+	seconds float64
+}
+
+// metrics 服务的统计, 以 Prometheus 文本格式输出
+type metrics struct {
+	mu        sync.Mutex
+	endpoints map[string]*endpointMetrics
+	// reloads, reloadErrors 重新读取用户词典的次数和失败次数
+	reloads      int64
+	reloadErrors int64
+	started      time.Time
+}
+
+// newMetrics 创建统计
+func newMetrics() *metrics {
+	return &metrics{endpoints: map[string]*endpointMetrics{}, started: time.Now()}
+}
+
+// observe 记录一个请求
+func (m *metrics) observe(endpoint string, status, texts int, d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.endpoints[endpoint]
+	if !ok {
+		e = &endpointMetrics{requests: map[int]int64{}}
+		m.endpoints[endpoint] = e
+	}
+	e.requests[status]++
+	e.texts += int64(texts)
+	e.seconds += d.Seconds()
+}
+
+// reloaded 记录一次重新读取用户词典
+func (m *metrics) reloaded(err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.reloads++
+	if err != nil {
+		m.reloadErrors++
+	}
+}
+
+// serve 输出统计
+func (m *metrics) serve(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var names []string
+	for name := range m.endpoints {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	fmt.Fprintln(w, "# HELP pinyin_requests_total Number of conversion requests by endpoint and status code.")
+	fmt.Fprintln(w, "# TYPE pinyin_requests_total counter")
+	for _, name := range names {
+		e := m.endpoints[name]
+		var codes []int
+		for code := range e.requests {
+			codes = append(codes, code)
+		}
+		sort.Ints(codes)
+		for _, code := range codes {
+			fmt.Fprintf(w, "pinyin_requests_total{endpoint=%q,code=\"%d\"} %d\n", name, code, e.requests[code])
+		}
+	}
+	fmt.Fprintln(w, "# HELP pinyin_texts_total Number of converted texts by endpoint.")
+	fmt.Fprintln(w, "# TYPE pinyin_texts_total counter")
+	for _, name := range names {
+		fmt.Fprintf(w, "pinyin_texts_total{endpoint=%q} %d\n", name, m.endpoints[name].texts)
+	}
+	fmt.Fprintln(w, "# HELP pinyin_request_seconds_total Total time spent handling requests by endpoint.")
+	fmt.Fprintln(w, "# TYPE pinyin_request_seconds_total counter")
+	for _, name := range names {
+		fmt.Fprintf(w, "pinyin_request_seconds_total{endpoint=%q} %s\n", name, strconv.FormatFloat(m.endpoints[name].seconds, 'f', -1, 64))
+	}
+	fmt.Fprintln(w, "# HELP pinyin_dict_reloads_total Number of user dictionary reloads.")
+	fmt.Fprintln(w, "# TYPE pinyin_dict_reloads_total counter")
+	fmt.Fprintf(w, "pinyin_dict_reloads_total %d\n", m.reloads)
+	fmt.Fprintln(w, "# HELP pinyin_dict_reload_errors_total Number of failed user dictionary reloads.")
+	fmt.Fprintln(w, "# TYPE pinyin_dict_reload_errors_total counter")
+	fmt.Fprintf(w, "pinyin_dict_reload_errors_total %d\n", m.reloadErrors)
+	fmt.Fprintln(w, "# HELP pinyin_uptime_seconds Time since the server started.")
+	fmt.Fprintln(w, "# TYPE pinyin_uptime_seconds gauge")
+	fmt.Fprintf(w, "pinyin_uptime_seconds %s\n", strconv.FormatFloat(time.Since(m.started).Seconds(), 'f', 3, 64))
+}
@@ -0,0 +1,266 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"pinyin-golang/pinyin"
+)
+
+// request 转换接口的请求, 一次转换多个文本
+type request struct {
+	// Texts 转换的文本
+	Texts []string `json:"texts"`
+	// Sep 音节之间的分隔符, 默认为空格, sentence 不使用
+	Sep *string `json:"sep"`
+	// Tone 声调: ascii, unicode (默认), none
+	Tone string `json:"tone"`
+	// V ü 的写法: v, umlaut, colon, yu, u
+	V string `json:"v"`
+	// Case 大小写: lower (默认), upper, title, first
+	Case string `json:"case"`
+}
+
+// response 转换接口的响应, results 与 texts 的顺序相同
+type response struct {
+	Results []interface{} `json:"results"`
+}
+
+// errorResponse 请求失败时的响应
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// token /tokens 返回的词语
+type token struct {
+	Text   string   `json:"text"`
+	Pinyin []string `json:"pinyin,omitempty"`
+}
+
+// style 请求指定的输出格式
+type style struct {
+	sep string
+	// format 按声调输出转换结果
+	format func(*pinyin.ConvertResult) string
+	// syllable 按声调输出音节
+	syllable func(pinyin.Syllable) string
+}
+
+// vStyles v 参数对应的 ü 的写法
+var vStyles = map[string]pinyin.VStyle{
+	"v":      pinyin.VStyleV,
+	"umlaut": pinyin.VStyleUmlaut,
+	"colon":  pinyin.VStyleColon,
+	"yu":     pinyin.VStyleYu,
+	"u":      pinyin.VStyleU,
+}
+
+// cases case 参数对应的大小写
+var cases = map[string]pinyin.Case{
+	"lower": pinyin.CaseLower,
+	"upper": pinyin.CaseUpper,
+	"title": pinyin.CaseTitle,
+	"first": pinyin.CaseFirstWordTitle,
+}
+
+// newStyle 按请求的参数创建输出格式
+func newStyle(req *request) (*style, error) {
+	st := &style{sep: " "}
+	if req.Sep != nil {
+		st.sep = *req.Sep
+	}
+
+	var opts []pinyin.Option
+	if req.V != "" {
+		v, ok := vStyles[req.V]
+		if !ok {
+			return nil, fmt.Errorf("unknown ü style %q", req.V)
+		}
+		opts = append(opts, pinyin.WithVStyle(v))
+	}
+	if req.Case != "" {
+		c, ok := cases[req.Case]
+		if !ok {
+			return nil, fmt.Errorf("unknown case %q", req.Case)
+		}
+		opts = append(opts, pinyin.WithCase(c))
+	}
+
+	switch req.Tone {
+	case "ascii":
+		st.format = func(r *pinyin.ConvertResult) string { return r.ASCII(opts...) }
+		st.syllable = func(s pinyin.Syllable) string { return s.ASCII(opts...) }
+	case "unicode", "":
+		st.format = func(r *pinyin.ConvertResult) string { return r.Unicode(opts...) }
+		st.syllable = func(s pinyin.Syllable) string { return s.Unicode(opts...) }
+	case "none":
+		st.format = func(r *pinyin.ConvertResult) string { return r.None(opts...) }
+		st.syllable = func(s pinyin.Syllable) string { return s.None(opts...) }
+	default:
+		return nil, fmt.Errorf("unknown tone style %q", req.Tone)
+	}
+	return st, nil
+}
+
+// endpoints 转换接口, 返回单个文本的结果
+var endpoints = map[string]func(dict *pinyin.Dict, st *style, text string) interface{}{
+	"convert": func(dict *pinyin.Dict, st *style, text string) interface{} {
+		return st.format(dict.Convert(text, st.sep))
+	},
+	"sentence": func(dict *pinyin.Dict, st *style, text string) interface{} {
+		return st.format(dict.Sentence(text))
+	},
+	"name": func(dict *pinyin.Dict, st *style, text string) interface{} {
+		return st.format(dict.Name(text, st.sep))
+	},
+	"abbr": func(dict *pinyin.Dict, st *style, text string) interface{} {
+		return dict.Abbr(text, st.sep)
+	},
+	"tokens": func(dict *pinyin.Dict, st *style, text string) interface{} {
+		tokens := []token{}
+		for _, t := range dict.Tokens(text) {
+			tk := token{Text: t.Text}
+			for _, s := range t.Syllables {
+				tk.Pinyin = append(tk.Pinyin, st.syllable(s))
+			}
+			tokens = append(tokens, tk)
+		}
+		return tokens
+	},
+	"heteronyms": func(dict *pinyin.Dict, st *style, text string) interface{} {
+		chars := [][]string{}
+		for _, readings := range dict.Heteronyms(text) {
+			var all []string
+			for _, s := range readings {
+				if text := st.syllable(s); !containsString(all, text) {
+					all = append(all, text)
+				}
+			}
+			chars = append(chars, all)
+		}
+		return chars
+	},
+}
+
+// server HTTP 接口
+type server struct {
+	store    *store
+	metrics  *metrics
+	maxBody  int64
+	maxTexts int
+}
+
+// newServer 创建 HTTP 接口
+func newServer(st *store, m *metrics, maxBody int64, maxTexts int) http.Handler {
+	s := &server{store: st, metrics: m, maxBody: maxBody, maxTexts: maxTexts}
+	mux := http.NewServeMux()
+	for name, fn := range endpoints {
+		mux.Handle("/"+name, s.convert(name, fn))
+	}
+	mux.HandleFunc("/healthz", s.health)
+	mux.HandleFunc("/metrics", s.metrics.serve)
+	mux.HandleFunc("/reload", s.reload)
+	return mux
+}
+
+// convert 返回转换接口的处理函数
+func (s *server) convert(name string, fn func(*pinyin.Dict, *style, string) interface{}) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		texts, status := 0, http.StatusOK
+		defer func() {
+			s.metrics.observe(name, status, texts, time.Since(start))
+		}()
+
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			status = writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+			return
+		}
+
+		var req request
+		decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, s.maxBody))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&req); err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				status = writeError(w, http.StatusRequestEntityTooLarge, fmt.Sprintf("request body exceeds %d bytes", s.maxBody))
+				return
+			}
+			status = writeError(w, http.StatusBadRequest, "invalid request: "+err.Error())
+			return
+		}
+		if req.Texts == nil {
+			status = writeError(w, http.StatusBadRequest, "missing texts")
+			return
+		}
+		if len(req.Texts) > s.maxTexts {
+			status = writeError(w, http.StatusRequestEntityTooLarge, fmt.Sprintf("too many texts: %d > %d", len(req.Texts), s.maxTexts))
+			return
+		}
+		st, err := newStyle(&req)
+		if err != nil {
+			status = writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// 同一个请求中的文本使用同一个词典, 不受重新读取的影响
+		dict := s.store.get()
+		resp := response{Results: make([]interface{}, 0, len(req.Texts))}
+		for _, text := range req.Texts {
+			resp.Results = append(resp.Results, fn(dict, st, text))
+		}
+		texts = len(req.Texts)
+		writeJSON(w, http.StatusOK, resp)
+	}
+}
+
+// health 服务状态和词典读取的时间
+func (s *server) health(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"status":         "ok",
+		"dicts":          s.store.files,
+		"dict_loaded_at": s.store.loaded().Format(time.RFC3339),
+	})
+}
+
+// reload 重新读取用户词典, 失败时继续使用原来的词典
+func (s *server) reload(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	if err := s.store.reload(s.metrics); err != nil {
+		writeError(w, http.StatusInternalServerError, strings.TrimPrefix(err.Error(), "pinyin: "))
+		return
+	}
+	s.health(w, r)
+}
+
+// writeJSON 输出 JSON 响应
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError 输出错误响应, 返回状态码
+func writeError(w http.ResponseWriter, status int, message string) int {
+	writeJSON(w, status, errorResponse{Error: message})
+	return status
+}
+
+// containsString 字符串数组中是否包含 s
+func containsString(a []string, s string) bool {
+	for _, item := range a {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func init() {
+	log.SetOutput(io.Discard)
+}
+
+// newTestServer 创建使用用户词典 files 的接口
+func newTestServer(t *testing.T, files ...string) http.Handler {
+	t.Helper()
+	st := &store{files: files}
+	if err := st.load(); err != nil {
+		t.Fatal(err)
+	}
+	return newServer(st, newMetrics(), 1024, 3)
+}
+
+// do 发送请求, 返回状态码和响应
+func do(h http.Handler, method, path, body string) (int, http.Header, string) {
+	r := httptest.NewRequest(method, path, strings.NewReader(body))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w.Code, w.Header(), w.Body.String()
+}
+
+// writeDict 写入用户词典
+func writeDict(t *testing.T, name, content string) {
+	t.Helper()
+	if err := os.WriteFile(name, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestServer_Convert(t *testing.T) {
+	tests := []struct {
+		path string
+		body string
+		want string
+	}{
+		{"/convert", `{"texts":["重庆银行","中国"],"tone":"ascii"}`, `{"results":["chong2 qing4 yin2 hang2","zhong1 guo2"]}`},
+		{"/convert", `{"texts":["女"],"tone":"none","v":"u"}`, `{"results":["nu"]}`},
+		{"/convert", `{"texts":["中国"],"tone":"none","case":"upper","sep":"-"}`, `{"results":["ZHONG-GUO"]}`},
+		{"/convert", `{"texts":[]}`, `{"results":[]}`},
+		{"/sentence", `{"texts":["你好"],"tone":"none"}`, `{"results":["ni hao"]}`},
+		{"/name", `{"texts":["单雄信"],"tone":"none"}`, `{"results":["shan xiong xin"]}`},
+		{"/abbr", `{"texts":["重庆"],"sep":""}`, `{"results":["cq"]}`},
+		{"/tokens", `{"texts":["北京, iPhone"],"tone":"ascii"}`, `{"results":[[{"text":"北京","pinyin":["bei3","jing1"]},{"text":", iPhone"}]]}`},
+		{"/heteronyms", `{"texts":["中国"],"tone":"ascii"}`, `{"results":[[["zhong1","zhong4","zhong"],["guo2"]]]}`},
+	}
+	h := newTestServer(t)
+	for _, tt := range tests {
+		t.Run(tt.path[1:], func(t *testing.T) {
+			code, header, body := do(h, http.MethodPost, tt.path, tt.body)
+			if code != http.StatusOK {
+				t.Fatalf("POST %s %s = %d %s", tt.path, tt.body, code, body)
+			}
+			if ct := header.Get("Content-Type"); ct != "application/json; charset=utf-8" {
+				t.Errorf("POST %s Content-Type = %q", tt.path, ct)
+			}
+			if got := strings.TrimSpace(body); got != tt.want {
+				t.Errorf("POST %s %s = %s, want %s", tt.path, tt.body, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestServer_Error(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		body   string
+		code   int
+		want   string
+	}{
+		{"method", http.MethodGet, "", http.StatusMethodNotAllowed, "method not allowed"},
+		{"invalid_json", http.MethodPost, `{"texts":`, http.StatusBadRequest, "invalid request"},
+		{"unknown_field", http.MethodPost, `{"texts":[],"text":"中"}`, http.StatusBadRequest, `unknown field "text"`},
+		{"missing_texts", http.MethodPost, `{"tone":"ascii"}`, http.StatusBadRequest, "missing texts"},
+		{"too_many_texts", http.MethodPost, `{"texts":["一","二","三","四"]}`, http.StatusRequestEntityTooLarge, "too many texts: 4 > 3"},
+		{"too_large", http.MethodPost, `{"texts":["` + strings.Repeat("中", 400) + `"]}`, http.StatusRequestEntityTooLarge, "request body exceeds 1024 bytes"},
+		{"tone", http.MethodPost, `{"texts":[],"tone":"number"}`, http.StatusBadRequest, `unknown tone style "number"`},
+		{"v", http.MethodPost, `{"texts":[],"v":"x"}`, http.StatusBadRequest, `unknown ü style "x"`},
+		{"case", http.MethodPost, `{"texts":[],"case":"camel"}`, http.StatusBadRequest, `unknown case "camel"`},
+	}
+	h := newTestServer(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, header, body := do(h, tt.method, "/convert", tt.body)
+			if code != tt.code {
+				t.Errorf("%s /convert = %d, want %d", tt.method, code, tt.code)
+			}
+			var resp errorResponse
+			if err := json.Unmarshal([]byte(body), &resp); err != nil || !strings.Contains(resp.Error, tt.want) {
+				t.Errorf("%s /convert = %s, want error %q", tt.method, body, tt.want)
+			}
+			if tt.code == http.StatusMethodNotAllowed && header.Get("Allow") != http.MethodPost {
+				t.Errorf("%s /convert Allow = %q, want POST", tt.method, header.Get("Allow"))
+			}
+		})
+	}
+}
+
+func TestServer_Metrics(t *testing.T) {
+	h := newTestServer(t)
+	do(h, http.MethodPost, "/convert", `{"texts":["中","国"]}`)
+	do(h, http.MethodPost, "/convert", `{"texts":["中"]}`)
+	do(h, http.MethodPost, "/convert", `{"tone":"ascii"}`)
+	do(h, http.MethodGet, "/abbr", "")
+
+	code, header, body := do(h, http.MethodGet, "/metrics", "")
+	if code != http.StatusOK || !strings.HasPrefix(header.Get("Content-Type"), "text/plain") {
+		t.Fatalf("GET /metrics = %d %q", code, header.Get("Content-Type"))
+	}
+	for _, want := range []string{
+		`pinyin_requests_total{endpoint="abbr",code="405"} 1`,
+		`pinyin_requests_total{endpoint="convert",code="200"} 2`,
+		`pinyin_requests_total{endpoint="convert",code="400"} 1`,
+		`pinyin_texts_total{endpoint="abbr"} 0`,
+		`pinyin_texts_total{endpoint="convert"} 3`,
+		`pinyin_dict_reloads_total 0`,
+		`pinyin_dict_reload_errors_total 0`,
+		`# TYPE pinyin_uptime_seconds gauge`,
+	} {
+		if !strings.Contains(body, want+"\n") {
+			t.Errorf("GET /metrics missing %q in:\n%s", want, body)
+		}
+	}
+}
+
+func TestServer_Reload(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "user.dict")
+	writeDict(t, name, "重庆 zhong4 qing4\n")
+	h := newTestServer(t, name)
+	convert := func() string {
+		_, _, body := do(h, http.MethodPost, "/convert", `{"texts":["重庆"],"tone":"ascii"}`)
+		return strings.TrimSpace(body)
+	}
+	if got, want := convert(), `{"results":["zhong4 qing4"]}`; got != want {
+		t.Fatalf("POST /convert = %s, want %s", got, want)
+	}
+
+	code, _, body := do(h, http.MethodGet, "/healthz", "")
+	var health struct {
+		Status string   `json:"status"`
+		Dicts  []string `json:"dicts"`
+	}
+	if err := json.Unmarshal([]byte(body), &health); code != http.StatusOK || err != nil || health.Status != "ok" || len(health.Dicts) != 1 || health.Dicts[0] != name {
+		t.Errorf("GET /healthz = %d %s", code, body)
+	}
+
+	if code, _, _ := do(h, http.MethodGet, "/reload", ""); code != http.StatusMethodNotAllowed {
+		t.Errorf("GET /reload = %d, want %d", code, http.StatusMethodNotAllowed)
+	}
+
+	writeDict(t, name, "重庆 chong2 qing4\n")
+	if code, _, body := do(h, http.MethodPost, "/reload", ""); code != http.StatusOK || !strings.Contains(body, `"status":"ok"`) {
+		t.Errorf("POST /reload = %d %s", code, body)
+	}
+	if got, want := convert(), `{"results":["chong2 qing4"]}`; got != want {
+		t.Errorf("POST /convert after reload = %s, want %s", got, want)
+	}
+
+	// 读取失败时继续使用原来的词典
+	writeDict(t, name, "重庆 chong9 qing4\n")
+	code, _, body = do(h, http.MethodPost, "/reload", "")
+	if code != http.StatusInternalServerError || !strings.Contains(body, name) {
+		t.Errorf("POST /reload = %d %s, want error for %s", code, body, name)
+	}
+	if got, want := convert(), `{"results":["chong2 qing4"]}`; got != want {
+		t.Errorf("POST /convert after failed reload = %s, want %s", got, want)
+	}
+
+	_, _, body = do(h, http.MethodGet, "/metrics", "")
+	for _, want := range []string{"pinyin_dict_reloads_total 2\n", "pinyin_dict_reload_errors_total 1\n"} {
+		if !strings.Contains(body, want) {
+			t.Errorf("GET /metrics missing %q", want)
+		}
+	}
+}
@@ -0,0 +1,344 @@
+// pinyin 命令行工具, 按行转换文件或标准输入中的中文, 或为 CSV/TSV 文件添加拼音列
+//
+//	pinyin -mode sentence -tone unicode input.txt
+//	cat names.txt | pinyin -mode name -sep - -tone none
+//	pinyin -format csv -columns name:name,name:abbr customers.csv
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"strings"
+
+	"pinyin-golang/pinyin"
+	"pinyin-golang/pinyin/encoding"
+)
+
+// stringsFlag 可以重复指定的参数
+type stringsFlag []string
+
+func (f *stringsFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *stringsFlag) Set(s string) error {
+	*f = append(*f, s)
+	return nil
+}
+
+// config 命令行参数
+type config struct {
+	mode      string
+	sep       string
+	tone      string
+	v         string
+	heteronym bool
+	dicts     stringsFlag
+	workers   int
+	format    string
+	columns   string
+	header    bool
+	encoding  string
+	// output 输出编码, input 表示与输入相同
+	output string
+}
+
+// vStyles -v 参数对应的 ü 的写法
+var vStyles = map[string]pinyin.VStyle{
+	"v":      pinyin.VStyleV,
+	"umlaut": pinyin.VStyleUmlaut,
+	"colon":  pinyin.VStyleColon,
+	"yu":     pinyin.VStyleYu,
+	"u":      pinyin.VStyleU,
+}
+
+func main() {
+	var c config
+	flag.StringVar(&c.mode, "mode", "convert", "转换模式: convert, sentence, name, abbr")
+	flag.StringVar(&c.sep, "sep", " ", "拼音之间的分隔符, sentence 模式不使用")
+	flag.StringVar(&c.tone, "tone", "unicode", "声调: ascii, unicode, none")
+	flag.StringVar(&c.v, "v", "", "ü 的写法: v, umlaut, colon, yu, u")
+	flag.BoolVar(&c.heteronym, "heteronym", false, "输出多音字的所有读音, 以 / 分隔, 只用于 convert 模式")
+	flag.Var(&c.dicts, "dict", "用户词典文件, 每行一个词语和读音, 可以重复指定")
+	flag.IntVar(&c.workers, "workers", runtime.NumCPU(), "并行转换的协程数, 只用于 lines 格式")
+	flag.StringVar(&c.format, "format", "lines", "输入格式: lines (按行转换), csv, tsv")
+	flag.StringVar(&c.columns, "columns", "", "csv/tsv 中转换的列和模式, 如 name:name,id:abbr, 列可以是列名或从 1 开始的序号")
+	flag.BoolVar(&c.header, "header", true, "csv/tsv 的第一行是否为列名")
+	flag.StringVar(&c.encoding, "encoding", "utf-8", "输入编码: utf-8, gb18030, gbk, big5, auto (自动检测)")
+	flag.StringVar(&c.output, "output-encoding", "utf-8", "输出编码: utf-8, gb18030, gbk, big5, input (与输入相同); Big5 不能编码 Unicode 声调")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "用法: %s [参数] [文件 ...]\n不指定文件或文件为 - 时读取标准输入\n\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if err := run(c, flag.Args(), os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "pinyin:", strings.TrimPrefix(err.Error(), "pinyin: "))
+		os.Exit(1)
+	}
+}
+
+// fileError 在错误信息前加上文件名
+func fileError(name string, err error) error {
+	return fmt.Errorf("%s: %s", name, strings.TrimPrefix(err.Error(), "pinyin: "))
+}
+
+// run 按行转换文件, 结果按输入的顺序写入 w
+func run(c config, files []string, w io.Writer) error {
+	cv, err := newConverter(c)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		files = []string{"-"}
+	}
+	if c.output != "input" {
+		if _, err := encoding.Parse(c.output); err != nil {
+			return err
+		}
+	}
+
+	var convert func(io.Reader, *bufio.Writer, source) error
+	switch c.format {
+	case "lines":
+		fn, err := cv.modeFunc(c.mode, c.heteronym)
+		if err != nil {
+			return err
+		}
+		convert = func(r io.Reader, out *bufio.Writer, _ source) error {
+			return convertLines(r, out, fn, c.workers)
+		}
+	case "csv", "tsv":
+		columns, err := parseColumns(c.columns)
+		if err != nil {
+			return err
+		}
+		convert = func(r io.Reader, out *bufio.Writer, src source) error {
+			return convertTable(r, out, src, cv, columns, c.format == "tsv", c.header)
+		}
+	default:
+		return fmt.Errorf("unknown format %q", c.format)
+	}
+
+	out := bufio.NewWriter(w)
+	defer out.Flush()
+	for _, name := range files {
+		if err := convertFile(name, c.encoding, c.output, convert, out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// source 输入文件的编码
+type source struct {
+	enc encoding.Encoding
+	// bom 是否带有 UTF-8 的 BOM, 输出不是 UTF-8 时为 false
+	bom bool
+}
+
+// detectSize 检测编码时读取的字节数
+const detectSize = 64 * 1024
+
+// convertFile 按编码解码并转换一个文件, 按 output 编码输出, 文件中的无效字节序列在转换完成后报告
+func convertFile(name, charset, output string, convert func(io.Reader, *bufio.Writer, source) error, out *bufio.Writer) error {
+	var r io.Reader = os.Stdin
+	if name != "-" {
+		f, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	br := bufio.NewReaderSize(r, detectSize)
+	head, _ := br.Peek(detectSize)
+	var src source
+	if charset == "auto" {
+		src.enc = encoding.Detect(head)
+	} else {
+		enc, err := encoding.Parse(charset)
+		if err != nil {
+			return err
+		}
+		src.enc = enc
+	}
+	outEnc := src.enc
+	if output != "input" {
+		outEnc, _ = encoding.Parse(output)
+	}
+	src.bom = src.enc == encoding.UTF8 && outEnc == encoding.UTF8 && bytes.HasPrefix(head, []byte("\xef\xbb\xbf"))
+
+	decoder := encoding.NewDecoder(br, src.enc)
+	if outEnc == encoding.UTF8 {
+		if err := convert(decoder, out, src); err != nil {
+			return fileError(name, err)
+		}
+	} else {
+		// 编码器之前的缓冲区在文件结束时写入, 无法编码的字符在 Flush 时报告
+		w := bufio.NewWriter(encoding.NewEncoder(out, outEnc))
+		if err := convert(decoder, w, src); err != nil {
+			return fileError(name, err)
+		}
+		if err := w.Flush(); err != nil {
+			return fileError(name, err)
+		}
+	}
+	if err := decoder.Err(); err != nil {
+		return fileError(name, err)
+	}
+	return nil
+}
+
+// converter 按参数转换单行的文本
+type converter struct {
+	dict *pinyin.Dict
+	sep  string
+	// format 按声调输出转换结果
+	format func(*pinyin.ConvertResult) string
+	// syllable 按声调输出音节
+	syllable func(pinyin.Syllable) string
+}
+
+// newConverter 按参数创建转换器, 读取用户词典
+func newConverter(c config) (*converter, error) {
+	cv := &converter{dict: pinyin.NewDict(), sep: c.sep}
+	for _, name := range c.dicts {
+		f, err := os.Open(name)
+		if err != nil {
+			return nil, err
+		}
+		err = cv.dict.LoadWords(f)
+		f.Close()
+		if err != nil {
+			return nil, fileError(name, err)
+		}
+	}
+
+	var opts []pinyin.Option
+	if c.v != "" {
+		v, ok := vStyles[c.v]
+		if !ok {
+			return nil, fmt.Errorf("unknown ü style %q", c.v)
+		}
+		opts = append(opts, pinyin.WithVStyle(v))
+	}
+
+	switch c.tone {
+	case "ascii":
+		cv.format = func(r *pinyin.ConvertResult) string { return r.ASCII(opts...) }
+		cv.syllable = func(s pinyin.Syllable) string { return s.ASCII(opts...) }
+	case "unicode":
+		cv.format = func(r *pinyin.ConvertResult) string { return r.Unicode(opts...) }
+		cv.syllable = func(s pinyin.Syllable) string { return s.Unicode(opts...) }
+	case "none":
+		cv.format = func(r *pinyin.ConvertResult) string { return r.None(opts...) }
+		cv.syllable = func(s pinyin.Syllable) string { return s.None(opts...) }
+	default:
+		return nil, fmt.Errorf("unknown tone style %q", c.tone)
+	}
+	return cv, nil
+}
+
+// modeFunc 按转换模式返回单行的转换函数
+func (cv *converter) modeFunc(mode string, heteronym bool) (func(string) string, error) {
+	if heteronym {
+		if mode != "convert" {
+			return nil, fmt.Errorf("-heteronym is only supported in convert mode")
+		}
+		return cv.heteronyms, nil
+	}
+
+	switch mode {
+	case "convert":
+		return func(line string) string { return cv.format(cv.dict.Convert(line, cv.sep)) }, nil
+	case "sentence":
+		return func(line string) string { return cv.format(cv.dict.Sentence(line)) }, nil
+	case "name":
+		return func(line string) string { return cv.format(cv.dict.Name(line, cv.sep)) }, nil
+	case "abbr":
+		return func(line string) string { return cv.dict.Abbr(line, cv.sep) }, nil
+	}
+	return nil, fmt.Errorf("unknown mode %q", mode)
+}
+
+// heteronyms 输出多音字的所有读音, 以 / 分隔
+func (cv *converter) heteronyms(line string) string {
+	var split []string
+	for _, readings := range cv.dict.Heteronyms(line) {
+		var all []string
+		for _, s := range readings {
+			if text := cv.syllable(s); !containsString(all, text) {
+				all = append(all, text)
+			}
+		}
+		split = append(split, strings.Join(all, "/"))
+	}
+	return strings.Join(split, cv.sep)
+}
+
+// convertLines 并行转换每一行, 按输入的顺序输出, 内存占用与文件大小无关
+func convertLines(r io.Reader, out *bufio.Writer, convert func(string) string, workers int) error {
+	if workers < 1 {
+		workers = 1
+	}
+
+	// 每一行的结果通过各自的 channel 按顺序交给 out
+	type job struct {
+		line   string
+		result chan string
+	}
+	jobs := make(chan job, workers*4)
+	queue := make(chan chan string, workers*4)
+
+	for i := 0; i < workers; i++ {
+		go func() {
+			for j := range jobs {
+				j.result <- convert(j.line)
+			}
+		}()
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		var err error
+		for result := range queue {
+			line := <-result
+			if err == nil {
+				_, err = out.WriteString(line + "\n")
+			}
+		}
+		done <- err
+	}()
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	for scanner.Scan() {
+		result := make(chan string, 1)
+		queue <- result
+		jobs <- job{line: scanner.Text(), result: result}
+	}
+	close(jobs)
+	close(queue)
+
+	if err := <-done; err != nil {
+		return err
+	}
+	return scanner.Err()
+}
+
+// containsString 字符串数组中是否包含 s
+func containsString(a []string, s string) bool {
+	for _, item := range a {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// column 需要转换的列
+type column struct {
+	// name 列名或从 1 开始的序号
+	name string
+	// mode 转换模式
+	mode string
+	// index 列的位置, 读取列名后确定
+	index int
+}
+
+// parseColumns 解析 -columns 参数, 如 name:name,id:abbr, 省略模式时为 convert
+func parseColumns(s string) ([]*column, error) {
+	var columns []*column
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		c := &column{name: item, mode: "convert", index: -1}
+		if i := strings.LastIndexByte(item, ':'); i >= 0 {
+			c.name, c.mode = item[:i], item[i+1:]
+		}
+		columns = append(columns, c)
+	}
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("-columns is required for csv and tsv")
+	}
+	return columns, nil
+}
+
+// header 新增的列名, abbr 模式为 <列名>_abbr, 其他模式为 <列名>_pinyin
+func (c *column) header(names []string) string {
+	name := c.name
+	if c.index < len(names) {
+		name = names[c.index]
+	}
+	if c.mode == "abbr" {
+		return name + "_abbr"
+	}
+	return name + "_pinyin"
+}
+
+// resolve 按列名或序号确定列的位置
+func (c *column) resolve(names []string) error {
+	for i, name := range names {
+		if name == c.name {
+			c.index = i
+			return nil
+		}
+	}
+	if n, err := strconv.Atoi(c.name); err == nil && n >= 1 {
+		c.index = n - 1
+		return nil
+	}
+	return fmt.Errorf("unknown column %q", c.name)
+}
+
+// convertTable 为 CSV/TSV 的每一行追加拼音列, 输出 UTF-8, 保留 BOM, TSV 不处理引号
+// r 为解码后的 UTF-8 文本
+func convertTable(r io.Reader, out *bufio.Writer, src source, cv *converter, columns []*column, tsv, header bool) error {
+	if src.bom {
+		out.WriteString("\xef\xbb\xbf")
+	}
+	var reader recordReader
+	var writer recordWriter
+	if tsv {
+		reader, writer = &tsvReader{r: bufio.NewReader(r)}, &tsvWriter{w: out}
+	} else {
+		cr := csv.NewReader(r)
+		cr.FieldsPerRecord = -1
+		reader, writer = cr, csv.NewWriter(out)
+	}
+
+	funcs := make([]func(string) string, len(columns))
+	for i, c := range columns {
+		fn, err := cv.modeFunc(c.mode, false)
+		if err != nil {
+			return err
+		}
+		funcs[i] = fn
+	}
+
+	// width 第一行的列数, 较短的行补齐后再追加拼音列
+	width := 0
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if line == 1 {
+			width = len(record)
+			var names []string
+			if header {
+				names = record
+			}
+			for _, c := range columns {
+				if err := c.resolve(names); err != nil {
+					return err
+				}
+			}
+			if header {
+				for _, c := range columns {
+					record = append(record, c.header(names))
+				}
+				if err := writer.Write(record); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
+		n := len(record)
+		for len(record) < width {
+			record = append(record, "")
+		}
+		for i, c := range columns {
+			var value string
+			if c.index < n {
+				value = funcs[i](record[c.index])
+			}
+			record = append(record, value)
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// recordReader 按行读取 CSV/TSV 记录
+type recordReader interface {
+	Read() ([]string, error)
+}
+
+// recordWriter 按行写入 CSV/TSV 记录
+type recordWriter interface {
+	Write(record []string) error
+	Flush()
+	Error() error
+}
+
+// tsvReader 读取 TSV, 每行按制表符切分, 不处理引号
+type tsvReader struct {
+	r *bufio.Reader
+}
+
+func (t *tsvReader) Read() ([]string, error) {
+	line, err := t.r.ReadString('\n')
+	if line == "" {
+		if err == nil {
+			err = io.EOF
+		}
+		return nil, err
+	}
+	line = strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")
+	return strings.Split(line, "\t"), nil
+}
+
+// tsvWriter 写入 TSV, 字段以制表符连接, 不加引号
+type tsvWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (t *tsvWriter) Write(record []string) error {
+	if t.err == nil {
+		_, t.err = t.w.WriteString(strings.Join(record, "\t") + "\n")
+	}
+	return t.err
+}
+
+func (t *tsvWriter) Flush() {}
+
+func (t *tsvWriter) Error() error {
+	return t.err
+}
@@ -0,0 +1,203 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"pinyin-golang/pinyin/encoding"
+)
+
+// testConfig 与命令行参数的默认值相同
+func testConfig() config {
+	return config{mode: "convert", sep: " ", tone: "unicode", workers: 1, format: "csv", header: true, encoding: "utf-8", output: "utf-8"}
+}
+
+// runFile 将 input 写入临时文件并转换, 返回输出
+func runFile(t *testing.T, c config, input []byte) (string, error) {
+	t.Helper()
+	name := filepath.Join(t.TempDir(), "input")
+	if err := os.WriteFile(name, input, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	err := run(c, []string{name}, &out)
+	return out.String(), err
+}
+
+func TestConvertTable(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		columns string
+		header  bool
+		tone    string
+		input   string
+		want    string
+	}{
+		{
+			"header", "csv", "name", true, "unicode",
+			"id,name\n1,张三\n",
+			"id,name,name_pinyin\n1,张三,zhāng sān\n",
+		},
+		{
+			"modes", "csv", "name:name,city:abbr", true, "none",
+			"name,city\n单雄信,重庆\n",
+			"name,city,name_pinyin,city_abbr\n单雄信,重庆,shan xiong xin,c q\n",
+		},
+		{
+			"index", "csv", "2", false, "ascii",
+			"1,重庆\n2,西安\n",
+			"1,重庆,chong2 qing4\n2,西安,xi1 an1\n",
+		},
+		{
+			"quoting", "csv", "text", true, "none",
+			"text\n\"你好, 世界\"\n\"他说\"\"好\"\"\"\n",
+			"text,text_pinyin\n\"你好, 世界\",ni hao shi jie\n\"他说\"\"好\"\"\",ta shuo hao\n",
+		},
+		{
+			"multiline", "csv", "text", true, "none",
+			"text\n\"第一句\n第二句\"\n",
+			"text,text_pinyin\n\"第一句\n第二句\",di yi ju di er ju\n",
+		},
+		{
+			"short_row", "csv", "b", true, "none",
+			"a,b,c\n你\n",
+			"a,b,c,b_pinyin\n你,,,\n",
+		},
+		{
+			"tsv", "tsv", "name", true, "none",
+			"id\tname\n1\t李\"四\n",
+			"id\tname\tname_pinyin\n1\t李\"四\tli si\n",
+		},
+		{
+			"tsv_quotes", "tsv", "name", true, "none",
+			"id\tname\r\n1\t\"李四\"\n2\t王, 五",
+			"id\tname\tname_pinyin\n1\t\"李四\"\tli si\n2\t王, 五\twang wu\n",
+		},
+		{
+			"bom", "csv", "name", true, "none",
+			"\xef\xbb\xbfname\n王五\n",
+			"\xef\xbb\xbfname,name_pinyin\n王五,wang wu\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := testConfig()
+			c.format, c.columns, c.header, c.tone = tt.format, tt.columns, tt.header, tt.tone
+			got, err := runFile(t, c, []byte(tt.input))
+			if err != nil {
+				t.Fatalf("run() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("run() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertTable_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		columns string
+		input   string
+		want    string
+	}{
+		{"no_columns", "", "name\n张三\n", "-columns is required"},
+		{"unknown_column", "title", "name\n张三\n", `unknown column "title"`},
+		{"unknown_mode", "name:pinyin", "name\n张三\n", `unknown mode "pinyin"`},
+		{"bad_quote", "name", "name\n\"张三\n", "extraneous or missing"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := testConfig()
+			c.columns = tt.columns
+			_, err := runFile(t, c, []byte(tt.input))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("run() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertTable_Encoding(t *testing.T) {
+	input, err := encoding.Encode("name\n张三\n", encoding.GBK)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "name,name_pinyin\n张三,zhang san\n"
+	tests := []struct {
+		charset string
+		output  string
+		// enc 输出的编码
+		enc encoding.Encoding
+	}{
+		{"gbk", "utf-8", encoding.UTF8},
+		{"auto", "utf-8", encoding.UTF8},
+		{"gbk", "input", encoding.GBK},
+		{"auto", "input", encoding.GBK},
+		{"gbk", "gb18030", encoding.GB18030},
+	}
+	for _, tt := range tests {
+		t.Run(tt.charset+"_"+tt.output, func(t *testing.T) {
+			c := testConfig()
+			c.columns, c.tone, c.encoding, c.output = "name", "none", tt.charset, tt.output
+			got, err := runFile(t, c, input)
+			if err != nil {
+				t.Fatalf("run() error = %v", err)
+			}
+			decoded, err := encoding.Decode([]byte(got), tt.enc)
+			if err != nil {
+				t.Fatalf("Decode() error = %v", err)
+			}
+			if decoded != want {
+				t.Errorf("run() = %q, want %q", decoded, want)
+			}
+		})
+	}
+}
+
+func TestConvertTable_OutputEncoding(t *testing.T) {
+	input, err := encoding.Encode("name\n媽媽\n", encoding.Big5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 默认输出 UTF-8, Unicode 声调不受输入编码的限制
+	c := testConfig()
+	c.columns, c.encoding = "name", "big5"
+	got, err := runFile(t, c, input)
+	if err != nil {
+		t.Fatalf("run() error = %v", err)
+	}
+	if want := "name,name_pinyin\n媽媽,mā mā\n"; got != want {
+		t.Errorf("run() = %q, want %q", got, want)
+	}
+
+	// Big5 不能编码 Unicode 声调
+	c.output = "input"
+	if _, err := runFile(t, c, input); err == nil || !strings.Contains(err.Error(), "cannot be encoded in big5") {
+		t.Errorf("run() error = %v, want encode error", err)
+	}
+
+	c.output = "latin1"
+	if _, err := runFile(t, c, input); err == nil || !strings.Contains(err.Error(), "latin1") {
+		t.Errorf("run() error = %v, want unknown encoding", err)
+	}
+}
+
+func TestConvertFile_DetectBoundary(t *testing.T) {
+	// 中 跨过检测编码时读取的 64KB
+	input := strings.Repeat("a", detectSize-1) + "中\n"
+	c := testConfig()
+	c.format, c.tone, c.encoding = "lines", "none", "auto"
+	got, err := runFile(t, c, []byte(input))
+	if err != nil {
+		t.Fatalf("run() error = %v", err)
+	}
+	if !strings.HasSuffix(got, "zhong\n") {
+		t.Errorf("run() = %q, want suffix %q", got[len(got)-20:], "zhong\n")
+	}
+}
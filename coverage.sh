@@ -5,7 +5,7 @@ set -e
 echo "mode: count" > coverage.out
 
 for d in $(go list ./...); do
-	if [ "$d" != "github.com/Lofanmi/pinyin-golang/demo" ]; then
+	if [ "$d" != "pinyin-golang/demo" ]; then
 		go test -v -covermode=count -coverprofile=profile.out $d
 		if [ -f profile.out ]; then
 			cat profile.out | grep -v "mode:" >> coverage.out
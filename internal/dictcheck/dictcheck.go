@@ -0,0 +1,299 @@
+// Package dictcheck 检查词典中的读音
+package dictcheck
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"pinyin-golang/internal/dictfile"
+	"pinyin-golang/pinyin"
+)
+
+// 问题的级别
+const (
+	Error   = "error"
+	Warning = "warning"
+)
+
+// Checks 所有检查的名称和说明
+var Checks = map[string]string{
+	"syllable":  "不合法的音节, 或不是带数字声调, ü 写作 v 的写法",
+	"count":     "字数与音节数不同",
+	"tone":      "词语的所有音节都没有声调, 或单字只有轻声的读音",
+	"reading":   "词语中的字的读音与单字的读音不同, 且没有其他词语使用这个读音",
+	"erhua":     "儿化词语中的 儿 与大多数儿化词语的读音不同",
+	"duplicate": "同一个文件中重复的词语",
+	"shadowed":  "同一个文件中读音不同的重复词语, 其中一个不会被使用",
+	"override":  "与词典相同的本地修改, 或删除词典中没有的词语",
+}
+
+// Kind 词语的来源
+type Kind int
+
+const (
+	// Dict 内置词典, 重复的词语以第一个为准
+	Dict Kind = iota
+	// Overrides 本地修改, 优先于 Dict
+	Overrides
+	// Names 姓名的读音表, 重复的词语以最后一个为准, 不检查与单字读音的一致性
+	Names
+)
+
+// Source 一个文件中的词语
+type Source struct {
+	// File 文件名
+	File string
+	// Kind 来源
+	Kind Kind
+	// Entries 按文件中的顺序排列的词语, Line 为行号
+	Entries []dictfile.Entry
+}
+
+// Issue 检查发现的问题
+type Issue struct {
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+	Word     string `json:"word"`
+	Check    string `json:"check"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+}
+
+func (i Issue) String() string {
+	return fmt.Sprintf("%s:%d: %s: %s: %s: %s", i.File, i.Line, i.Severity, i.Check, i.Word, i.Message)
+}
+
+// lookalikes 与拼音字母形状相同的其他字符, 如 IPA 的 ɑ 和 ɡ, 出现在 overtrue/pinyin 的部分词语中
+var lookalikes = strings.NewReplacer("ɑ", "a", "ɡ", "g")
+
+// Normalize 将音节统一为带数字声调, ü 写作 v 的写法, 如 lǜ → lv4, lüè → lue4, 不是一个完整的音节时返回 false
+func Normalize(token string) (string, bool) {
+	token = lookalikes.Replace(token)
+	parsed, err := pinyin.ParsePinyin(token)
+	// ParsePinyin 跳过不是拼音的字符, 如 e㐀 中的 㐀, 字母数不同时不是一个完整的音节
+	if err != nil || len(parsed) != 1 || letterCount(token) != utf8.RuneCountInString(parsed[0].None(pinyin.WithVStyle(pinyin.VStyleUmlaut))) {
+		return "", false
+	}
+	if parsed[0].Tone == 0 {
+		return parsed[0].Text, true
+	}
+	return parsed[0].Text + strconv.Itoa(parsed[0].Tone), true
+}
+
+// letterCount 音节中的字母数, 不计算声调数字, 组合用声调符号和 u: 中的冒号
+func letterCount(token string) int {
+	n := 0
+	for _, r := range token {
+		if !unicode.IsDigit(r) && !unicode.Is(unicode.Mn, r) && r != ':' {
+			n++
+		}
+	}
+	return n
+}
+
+// toneless 去掉音节的声调
+func toneless(syllable string) string {
+	return strings.TrimRight(syllable, "012345")
+}
+
+// charReading 单字在词语中的读音
+type charReading struct {
+	char    rune
+	reading string
+}
+
+// checker 检查时使用的数据
+type checker struct {
+	disabled map[string]bool
+	issues   []Issue
+	// single 单字词条的读音
+	single map[rune]string
+	// used 每个字在多字词语中的读音 (不带声调) 出现的次数
+	used map[charReading]int
+	// toned 有带声调读音的字
+	toned map[rune]bool
+	// erhua 儿化词语中 儿 的读音 (不带声调) 出现的次数
+	erhua map[string]int
+	// erhuaReading 大多数儿化词语中 儿 的读音
+	erhuaReading string
+	// dict 词典中的词语
+	dict map[string]dictfile.Entry
+}
+
+// Check 检查所有词语, disabled 中的检查被跳过, 问题按文件和行号排列
+func Check(sources []Source, disabled map[string]bool) []Issue {
+	c := &checker{
+		disabled: disabled,
+		single:   map[rune]string{},
+		used:     map[charReading]int{},
+		toned:    map[rune]bool{},
+		erhua:    map[string]int{},
+		dict:     map[string]dictfile.Entry{},
+	}
+	for _, src := range sources {
+		if src.Kind != Names {
+			c.collect(src)
+		}
+	}
+	for reading, n := range c.erhua {
+		if m := c.erhua[c.erhuaReading]; n > m || n == m && reading < c.erhuaReading {
+			c.erhuaReading = reading
+		}
+	}
+	for _, src := range sources {
+		c.checkSource(src)
+	}
+	sort.SliceStable(c.issues, func(i, j int) bool {
+		if c.issues[i].File != c.issues[j].File {
+			return c.issues[i].File < c.issues[j].File
+		}
+		return c.issues[i].Line < c.issues[j].Line
+	})
+	return c.issues
+}
+
+// normalized 词语的音节, 不合法的音节为空字符串
+func normalized(e dictfile.Entry) []string {
+	syllables := make([]string, len(e.Syllables))
+	for i, s := range e.Syllables {
+		syllables[i], _ = Normalize(s)
+	}
+	return syllables
+}
+
+// isErhua 是否为儿化词语, 三个字以上且以 儿 结尾
+func isErhua(runes []rune) bool {
+	return len(runes) >= 3 && runes[len(runes)-1] == '儿'
+}
+
+// collect 统计词典中的读音
+func (c *checker) collect(src Source) {
+	for _, e := range src.Entries {
+		runes, syllables := []rune(e.Word), normalized(e)
+		if src.Kind == Dict {
+			if _, ok := c.dict[e.Word]; !ok {
+				c.dict[e.Word] = e
+			}
+		}
+		if len(runes) != len(syllables) {
+			continue
+		}
+		for i, r := range runes {
+			if syllables[i] == "" {
+				continue
+			}
+			if toneless(syllables[i]) != syllables[i] {
+				c.toned[r] = true
+			}
+			if len(runes) == 1 {
+				if _, ok := c.single[r]; !ok {
+					c.single[r] = syllables[i]
+				}
+			} else {
+				c.used[charReading{r, toneless(syllables[i])}]++
+			}
+		}
+		if isErhua(runes) && syllables[len(syllables)-1] != "" {
+			c.erhua[toneless(syllables[len(syllables)-1])]++
+		}
+	}
+}
+
+// report 记录问题
+func (c *checker) report(src Source, e dictfile.Entry, check, severity, format string, args ...interface{}) {
+	if c.disabled[check] {
+		return
+	}
+	c.issues = append(c.issues, Issue{
+		File: src.File, Line: e.Line, Word: e.Word, Check: check, Severity: severity,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// checkSource 检查一个文件中的词语
+func (c *checker) checkSource(src Source) {
+	seen := map[string]int{}
+	for k, e := range src.Entries {
+		if src.Kind == Overrides && len(e.Syllables) == 1 && e.Syllables[0] == dictfile.Delete {
+			if _, ok := c.dict[e.Word]; !ok {
+				c.report(src, e, "override", Warning, "deletes a word that is not in the dictionary")
+			}
+			continue
+		}
+		c.checkEntry(src, e)
+
+		if i, ok := seen[e.Word]; ok {
+			prev := src.Entries[i]
+			switch {
+			case strings.Join(prev.Syllables, " ") == strings.Join(e.Syllables, " "):
+				c.report(src, e, "duplicate", Warning, "duplicate of line %d", prev.Line)
+			case src.Kind == Names:
+				c.report(src, prev, "shadowed", Warning, "%s is never used, shadowed by line %d", strings.Join(prev.Syllables, " "), e.Line)
+			default:
+				c.report(src, e, "shadowed", Warning, "%s is never used, shadowed by line %d", strings.Join(e.Syllables, " "), prev.Line)
+			}
+			// 词典和本地修改中以第一个为准
+			if src.Kind != Names {
+				continue
+			}
+		}
+		seen[e.Word] = k
+
+		if d, ok := c.dict[e.Word]; ok && src.Kind == Overrides && strings.Join(d.Syllables, " ") == strings.Join(e.Syllables, " ") {
+			c.report(src, e, "override", Warning, "same reading as the dictionary, line %d", d.Line)
+		}
+	}
+}
+
+// checkEntry 检查一个词语的读音
+func (c *checker) checkEntry(src Source, e dictfile.Entry) {
+	runes := []rune(e.Word)
+	syllables := normalized(e)
+	valid := true
+	for i, s := range e.Syllables {
+		switch {
+		case syllables[i] == "":
+			c.report(src, e, "syllable", Error, "invalid syllable %q", s)
+			valid = false
+		case syllables[i] != s:
+			c.report(src, e, "syllable", Error, "syllable %q should be written %q", s, syllables[i])
+		}
+	}
+	if len(runes) != len(syllables) {
+		c.report(src, e, "count", Error, "%d characters but %d syllables", len(runes), len(syllables))
+		return
+	}
+	if !valid {
+		return
+	}
+
+	toned := false
+	for _, s := range syllables {
+		toned = toned || toneless(s) != s
+	}
+	switch {
+	case toned:
+	case len(runes) > 1:
+		c.report(src, e, "tone", Warning, "no syllable has a tone")
+	case !c.toned[runes[0]]:
+		c.report(src, e, "tone", Warning, "the only reading of %c has no tone", runes[0])
+	}
+
+	if src.Kind == Names || len(runes) == 1 {
+		return
+	}
+	for i, r := range runes {
+		single, ok := c.single[r]
+		reading := toneless(syllables[i])
+		if ok && reading != toneless(single) && c.used[charReading{r, reading}] < 2 && !(r == '儿' && isErhua(runes)) {
+			c.report(src, e, "reading", Warning, "%c read as %s, other entries read it as %s", r, syllables[i], single)
+		}
+	}
+	if last := toneless(syllables[len(syllables)-1]); isErhua(runes) && c.erhuaReading != "" && last != c.erhuaReading {
+		c.report(src, e, "erhua", Warning, "儿 read as %s, most erhua entries read it as %s", syllables[len(syllables)-1], c.erhuaReading)
+	}
+}
@@ -0,0 +1,179 @@
+package dictcheck
+
+import (
+	"strings"
+	"testing"
+
+	"pinyin-golang/internal/dictfile"
+)
+
+// singles 单字的读音, 用于检查词语中的字的读音
+const singles = `
+数 shu3
+米 mi3
+量 liang4
+柴 chai2
+思 si1
+所 suo3
+逐 zhu2
+之 zhi1
+一 yi1
+点 dian3
+会 hui4
+小 xiao3
+孩 hai2
+儿 er2
+你 ni3
+好 hao3
+`
+
+// source 由文本创建 Source
+func source(t *testing.T, file string, kind Kind, text string) Source {
+	t.Helper()
+	entries, err := dictfile.ReadText(strings.NewReader(text))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return Source{File: file, Kind: kind, Entries: entries}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		token string
+		want  string
+		ok    bool
+	}{
+		{"hao3", "hao3", true},
+		{"ma", "ma", true},
+		{"lǜ", "lv4", true},
+		{"lu:4", "lv4", true},
+		{"nü3", "nv3", true},
+		{"zhōng", "zhong1", true},
+		{"mɑ", "ma", true},
+		{"manɡ2", "mang2", true},
+		{"zh", "", false},
+		{"e㐀", "", false},
+		{"hao3hao3", "", false},
+	}
+	for _, tt := range tests {
+		if got, ok := Normalize(tt.token); got != tt.want || ok != tt.ok {
+			t.Errorf("Normalize(%q) = %q, %v, want %q, %v", tt.token, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name string
+		dict string
+		// overrides, names 为空时不检查
+		overrides string
+		names     string
+		want      []string
+	}{
+		{
+			name: "valid",
+			dict: "你好 ni3 hao3\n" + singles,
+		},
+		{
+			name: "syllable",
+			dict: "女人 nu:3 ren2\n密麻麻 mi4 ma2 mɑ\n日居月诸 ri4 ji1 yue4 zh\n",
+			want: []string{
+				`dict.txt:1: error: syllable: 女人: syllable "nu:3" should be written "nv3"`,
+				`dict.txt:2: error: syllable: 密麻麻: syllable "mɑ" should be written "ma"`,
+				`dict.txt:3: error: syllable: 日居月诸: invalid syllable "zh"`,
+			},
+		},
+		{
+			name: "count",
+			dict: "你好 ni3\n" + singles,
+			want: []string{
+				"dict.txt:1: error: count: 你好: 2 characters but 1 syllables",
+			},
+		},
+		{
+			name: "tone",
+			dict: "思所逐之 si shuo zhu zi\n呒 m\n" + singles,
+			want: []string{
+				"dict.txt:1: warning: tone: 思所逐之: no syllable has a tone",
+				"dict.txt:1: warning: reading: 思所逐之: 所 read as shuo, other entries read it as suo3",
+				"dict.txt:1: warning: reading: 思所逐之: 之 read as zi, other entries read it as zhi1",
+				"dict.txt:2: warning: tone: 呒: the only reading of 呒 has no tone",
+			},
+		},
+		{
+			name: "reading",
+			dict: "数米量柴 shu3 mi3 er2 chai2\n" + singles,
+			want: []string{
+				"dict.txt:1: warning: reading: 数米量柴: 量 read as er2, other entries read it as liang4",
+			},
+		},
+		{
+			// 其他词语也使用的读音不报告
+			name: "reading_used",
+			dict: "数量 shu4 liang4\n数目 shu4 mu4\n" + singles,
+		},
+		{
+			name: "erhua",
+			dict: "一点儿 yi1 dian3 r\n一会儿 yi1 hui4 r\n小孩儿 xiao3 hai2 er2\n" + singles,
+			want: []string{
+				"dict.txt:3: warning: erhua: 小孩儿: 儿 read as er2, most erhua entries read it as r",
+			},
+		},
+		{
+			name: "duplicate",
+			dict: "你好 ni3 hao3\n你好 ni3 hao3\n你好 ni2 hao3\n" + singles,
+			want: []string{
+				"dict.txt:2: warning: duplicate: 你好: duplicate of line 1",
+				"dict.txt:3: warning: shadowed: 你好: ni2 hao3 is never used, shadowed by line 1",
+			},
+		},
+		{
+			// 姓名的读音表中以最后一个为准
+			name:  "names",
+			dict:  singles,
+			names: "单 shan4\n单 dan1\n",
+			want: []string{
+				"names.go:1: warning: shadowed: 单: shan4 is never used, shadowed by line 2",
+			},
+		},
+		{
+			name:      "override",
+			dict:      "你好 ni3 hao3\n" + singles,
+			overrides: "你好 ni3 hao3\n某词 -\n",
+			want: []string{
+				"overrides.txt:1: warning: override: 你好: same reading as the dictionary, line 1",
+				"overrides.txt:2: warning: override: 某词: deletes a word that is not in the dictionary",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sources := []Source{source(t, "dict.txt", Dict, tt.dict)}
+			if tt.overrides != "" {
+				sources = append(sources, source(t, "overrides.txt", Overrides, tt.overrides))
+			}
+			if tt.names != "" {
+				sources = append(sources, source(t, "names.go", Names, tt.names))
+			}
+			var got []string
+			for _, issue := range Check(sources, nil) {
+				got = append(got, issue.String())
+			}
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("Check() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
+
+func TestCheck_Disabled(t *testing.T) {
+	sources := []Source{{File: "dict.txt", Kind: Dict, Entries: []dictfile.Entry{
+		{Word: "思所逐之", Syllables: []string{"si", "shuo", "zhu", "zi"}, Line: 1},
+		{Word: "所", Syllables: []string{"suo3"}, Line: 2},
+	}}}
+	issues := Check(sources, map[string]bool{"reading": true})
+	if len(issues) != 1 || issues[0].Check != "tone" || issues[0].Severity != Warning {
+		t.Errorf("Check() = %v, want only the tone warning", issues)
+	}
+}
@@ -0,0 +1,439 @@
+// Package dictfile 词典的文本格式和编译后的二进制格式
+//
+// 文本格式每行一个词语和带数字声调的读音, 以空白分隔, 忽略空行和 # 开头的注释, 排在前面的词语优先匹配:
+//
+//	重庆 chong2 qing4
+//
+// 二进制格式按词语的字节序排列, 每 blockSize 个词语为一块, 块内的词语只保存与前一个词语不同的后缀,
+// 音节保存为音节表中的序号. 查找时在块的第一个词语中二分查找, 再在块内顺序查找, 不需要解码整个词典.
+//
+//	magic "PYDICT" 版本 1
+//	uvarint 词语数, 最长词语的字数, 音节数, 块数
+//	音节表: uvarint 长度 + 音节
+//	块的位置: uint32 (little endian, 相对于词语数据的开头)
+//	词语数据: 共同前缀的字节数, 后缀的字节数, 后缀, 读音的字节数, 读音, 长度各占一个字节
+//	读音: uvarint 优先级, uvarint 音节数, uvarint 音节序号...
+package dictfile
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// magic 二进制格式的开头
+	magic = "PYDICT\x01"
+	// blockSize 每块的词语数
+	blockSize = 16
+	// maxKeyLen 词语和读音的最大字节数
+	maxKeyLen = 255
+)
+
+// Entry 词典中的词语
+type Entry struct {
+	// Word 词语
+	Word string
+	// Syllables 带数字声调的音节, 如 chong2 qing4
+	Syllables []string
+	// Line 在文本中的行号, 从 1 开始, 不是从文本读取时为 0
+	Line int
+}
+
+// ReadText 读取文本格式的词典, 按文本中的顺序返回
+func ReadText(r io.Reader) ([]Entry, error) {
+	var entries []Entry
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		if len(fields) == 1 {
+			return nil, fmt.Errorf("line %d: missing reading for %q", line, fields[0])
+		}
+		entries = append(entries, Entry{Word: fields[0], Syllables: fields[1:], Line: line})
+	}
+	return entries, scanner.Err()
+}
+
+// WriteText 按顺序输出文本格式的词典, header 中的每一行作为注释写在开头
+func WriteText(w io.Writer, header []string, entries []Entry) error {
+	bw := bufio.NewWriter(w)
+	for _, line := range header {
+		fmt.Fprintf(bw, "# %s\n", line)
+	}
+	for _, e := range entries {
+		fmt.Fprintf(bw, "%s %s\n", e.Word, strings.Join(e.Syllables, " "))
+	}
+	return bw.Flush()
+}
+
+// Delete 本地修改中删除词语的读音
+const Delete = "-"
+
+// Merge 合并本地修改和词典, 按字数从多到少稳定排序, 重复的词语保留第一个
+// overrides 优先于 entries, 读音为 Delete 的词语从结果中删除
+func Merge(entries, overrides []Entry) []Entry {
+	deleted := map[string]bool{}
+	all := make([]Entry, 0, len(overrides)+len(entries))
+	for _, e := range overrides {
+		if len(e.Syllables) == 1 && e.Syllables[0] == Delete {
+			deleted[e.Word] = true
+			continue
+		}
+		all = append(all, e)
+	}
+	all = append(all, entries...)
+	sort.SliceStable(all, func(i, j int) bool {
+		return utf8.RuneCountInString(all[i].Word) > utf8.RuneCountInString(all[j].Word)
+	})
+
+	result := all[:0]
+	for _, e := range all {
+		if !deleted[e.Word] {
+			deleted[e.Word] = true
+			result = append(result, e)
+		}
+	}
+	return result
+}
+
+// Encode 编译词典, entries 的顺序即优先级, 词语不能重复
+func Encode(entries []Entry) ([]byte, error) {
+	seen := map[string]bool{}
+	order := make([]int, 0, len(entries))
+	for i, e := range entries {
+		if len(e.Word) == 0 || len(e.Word) > maxKeyLen {
+			return nil, fmt.Errorf("word %q: length must be 1 to %d bytes", e.Word, maxKeyLen)
+		}
+		if len(e.Syllables) == 0 {
+			return nil, fmt.Errorf("word %q: missing reading", e.Word)
+		}
+		if seen[e.Word] {
+			return nil, fmt.Errorf("word %q: duplicate entry", e.Word)
+		}
+		seen[e.Word] = true
+		order = append(order, i)
+	}
+	sort.Slice(order, func(a, b int) bool { return entries[order[a]].Word < entries[order[b]].Word })
+
+	// 音节按出现次数排序, 常用的音节序号较小
+	counts := map[string]int{}
+	for _, i := range order {
+		for _, s := range entries[i].Syllables {
+			counts[s]++
+		}
+	}
+	names := make([]string, 0, len(counts))
+	for s := range counts {
+		names = append(names, s)
+	}
+	sort.Slice(names, func(a, b int) bool {
+		if counts[names[a]] != counts[names[b]] {
+			return counts[names[a]] > counts[names[b]]
+		}
+		return names[a] < names[b]
+	})
+	ids := map[string]int{}
+	for id, s := range names {
+		ids[s] = id
+	}
+
+	var data, blocks, record []byte
+	maxWordLen := 0
+	prev := ""
+	for k, i := range order {
+		e := entries[i]
+		if k%blockSize == 0 {
+			blocks = binary.LittleEndian.AppendUint32(blocks, uint32(len(data)))
+			prev = ""
+		}
+		shared := 0
+		for shared < len(prev) && shared < len(e.Word) && prev[shared] == e.Word[shared] {
+			shared++
+		}
+		data = append(data, byte(shared), byte(len(e.Word)-shared))
+		data = append(data, e.Word[shared:]...)
+		record = binary.AppendUvarint(record[:0], uint64(i))
+		record = binary.AppendUvarint(record, uint64(len(e.Syllables)))
+		for _, s := range e.Syllables {
+			record = binary.AppendUvarint(record, uint64(ids[s]))
+		}
+		if len(record) > maxKeyLen {
+			return nil, fmt.Errorf("word %q: too many syllables", e.Word)
+		}
+		data = append(data, byte(len(record)))
+		data = append(data, record...)
+		if n := utf8.RuneCountInString(e.Word); n > maxWordLen {
+			maxWordLen = n
+		}
+		prev = e.Word
+	}
+
+	out := []byte(magic)
+	out = binary.AppendUvarint(out, uint64(len(order)))
+	out = binary.AppendUvarint(out, uint64(maxWordLen))
+	out = binary.AppendUvarint(out, uint64(len(names)))
+	out = binary.AppendUvarint(out, uint64(len(blocks)/4))
+	for _, s := range names {
+		out = binary.AppendUvarint(out, uint64(len(s)))
+		out = append(out, s...)
+	}
+	out = append(out, blocks...)
+	return append(out, data...), nil
+}
+
+// errCorrupt 二进制数据不完整或格式错误
+var errCorrupt = errors.New("dictfile: corrupt dictionary")
+
+// Dict 编译后的词典, 直接读取二进制数据, 可以被多个 goroutine 同时使用
+type Dict struct {
+	// syllables 音节表
+	syllables []string
+	// blocks 每块在 data 中的位置
+	blocks []byte
+	// heads 每块的第一个词语
+	heads []string
+	// data 词语数据
+	data []byte
+	// count 词语数
+	count int
+	// maxWordLen 最长词语的字数
+	maxWordLen int
+}
+
+// reader 按顺序读取二进制数据
+type reader struct {
+	b   []byte
+	off int
+	err error
+}
+
+func (r *reader) uvarint() int {
+	// 大部分数值只有一个字节
+	if r.off < len(r.b) && r.b[r.off] < 0x80 {
+		r.off++
+		return int(r.b[r.off-1])
+	}
+	v, n := binary.Uvarint(r.b[r.off:])
+	if n <= 0 {
+		r.err = errCorrupt
+		r.off = len(r.b)
+		return 0
+	}
+	r.off += n
+	return int(v)
+}
+
+// u8 读取一个字节
+func (r *reader) u8() int {
+	if r.off >= len(r.b) {
+		r.err = errCorrupt
+		return 0
+	}
+	r.off++
+	return int(r.b[r.off-1])
+}
+
+func (r *reader) bytes(n int) []byte {
+	if n < 0 || r.off+n > len(r.b) {
+		r.err = errCorrupt
+		r.off = len(r.b)
+		return nil
+	}
+	b := r.b[r.off : r.off+n]
+	r.off += n
+	return b
+}
+
+// Open 读取编译后的词典, data 在 Dict 使用期间不能修改
+func Open(data []byte) (*Dict, error) {
+	if !strings.HasPrefix(string(data), magic) {
+		return nil, errors.New("dictfile: not a dictionary or unsupported version")
+	}
+	r := &reader{b: data, off: len(magic)}
+	d := &Dict{}
+	d.count = r.uvarint()
+	d.maxWordLen = r.uvarint()
+	syllables := r.uvarint()
+	blocks := r.uvarint()
+	if r.err != nil || syllables > len(data) || blocks > len(data) {
+		return nil, errCorrupt
+	}
+	d.syllables = make([]string, syllables)
+	for i := range d.syllables {
+		d.syllables[i] = string(r.bytes(r.uvarint()))
+	}
+	d.blocks = r.bytes(blocks * 4)
+	d.data = r.b[r.off:]
+	if r.err != nil {
+		return nil, r.err
+	}
+	// 二分查找时比较每块的第一个词语, 提前读出, 同时检查块的位置
+	d.heads = make([]string, blocks)
+	for i := range d.heads {
+		if d.block(i) >= len(d.data) || i > 0 && d.block(i) <= d.block(i-1) {
+			return nil, errCorrupt
+		}
+		hr := reader{b: d.data, off: d.block(i)}
+		if hr.u8() != 0 {
+			return nil, errCorrupt
+		}
+		d.heads[i] = string(hr.bytes(hr.u8()))
+		if hr.err != nil || i > 0 && d.heads[i] <= d.heads[i-1] {
+			return nil, errCorrupt
+		}
+	}
+	return d, nil
+}
+
+// Len 词语数
+func (d *Dict) Len() int {
+	return d.count
+}
+
+// MaxWordLen 最长词语的字数
+func (d *Dict) MaxWordLen() int {
+	return d.maxWordLen
+}
+
+// block 第 i 块在 data 中的位置
+func (d *Dict) block(i int) int {
+	return int(binary.LittleEndian.Uint32(d.blocks[4*i:]))
+}
+
+// Lookup 查找词语, 返回词语的位置和优先级, 不分配内存
+// longer 表示词典中是否有以 word 开头的更长的词语, 为 false 时不需要继续查找更长的词语
+func (d *Dict) Lookup(word string) (pos, priority int, found, longer bool) {
+	n := len(d.heads)
+	// 第一个词语大于 word 的块
+	i := sort.SearchStrings(d.heads, word)
+	if i < n && d.heads[i] == word {
+		i++
+	}
+	if i == 0 {
+		return 0, 0, false, n > 0 && strings.HasPrefix(d.heads[0], word)
+	}
+
+	r := reader{b: d.data, off: d.block(i - 1)}
+	end := len(d.data)
+	if i < n {
+		end = d.block(i)
+	}
+	// 按前缀压缩的顺序查找, m 为当前词语与 word 的共同前缀的字节数, 只比较与前一个词语不同的部分
+	m := 0
+	for r.off < end {
+		shared := r.u8()
+		suffix := r.bytes(r.u8())
+		record := r.bytes(r.u8())
+		if r.err != nil {
+			break
+		}
+		if found {
+			return pos, priority, true, shared >= len(word)
+		}
+		if shared > m {
+			// 与前一个词语的前 m+1 个字节相同, 仍小于 word
+			continue
+		}
+		if shared < m {
+			// 在前 m 个字节中大于 word
+			return 0, 0, false, false
+		}
+		k := 0
+		for k < len(suffix) && m+k < len(word) && suffix[k] == word[m+k] {
+			k++
+		}
+		m += k
+		switch {
+		case m == len(word) && k == len(suffix):
+			found, pos = true, r.off-len(record)
+			priority = (&reader{b: record}).uvarint()
+		case m == len(word):
+			// word 是当前词语的前缀
+			return 0, 0, false, true
+		case k < len(suffix) && suffix[k] > word[m]:
+			return 0, 0, false, false
+		}
+	}
+	if i < n {
+		return pos, priority, found, strings.HasPrefix(d.heads[i], word)
+	}
+	return pos, priority, found, false
+}
+
+// entry 读取下一个词语, key 为前一个词语, 返回这个词语和读音
+func (r *reader) entry(key []byte) ([]byte, []byte, bool) {
+	shared := r.u8()
+	suffix := r.bytes(r.u8())
+	record := r.bytes(r.u8())
+	if r.err != nil || shared > len(key) || shared+len(suffix) > maxKeyLen {
+		return key, nil, false
+	}
+	return append(key[:shared], suffix...), record, true
+}
+
+// hasPrefix 与 strings.HasPrefix 相同, 不转换 b
+func hasPrefix(b []byte, prefix string) bool {
+	return len(b) >= len(prefix) && string(b[:len(prefix)]) == prefix
+}
+
+// appendSyllables 将读音中的音节追加到 dst, 返回优先级
+func (d *Dict) appendSyllables(dst []string, record []byte) ([]string, int) {
+	r := reader{b: record}
+	priority := r.uvarint()
+	for k := r.uvarint(); k > 0 && r.err == nil; k-- {
+		if id := r.uvarint(); id < len(d.syllables) {
+			dst = append(dst, d.syllables[id])
+		}
+	}
+	return dst, priority
+}
+
+// AppendSyllables 将 Lookup 返回的位置上的词语的音节追加到 dst
+func (d *Dict) AppendSyllables(dst []string, pos int) []string {
+	dst, _ = d.appendSyllables(dst, d.data[pos:])
+	return dst
+}
+
+// Entries 返回所有词语, 按优先级排列, Line 为 0
+func (d *Dict) Entries() []Entry {
+	entries := make([]Entry, 0, d.count)
+	priorities := make([]int, 0, d.count)
+	var buf [maxKeyLen]byte
+	key := buf[:0]
+	r := reader{b: d.data}
+	for k := 0; k < d.count; k++ {
+		var record []byte
+		var ok bool
+		if key, record, ok = r.entry(key); !ok {
+			break
+		}
+		e := Entry{Word: string(key)}
+		var priority int
+		e.Syllables, priority = d.appendSyllables(nil, record)
+		entries = append(entries, e)
+		priorities = append(priorities, priority)
+	}
+	sort.Sort(byPriority{entries, priorities})
+	return entries
+}
+
+// byPriority 按优先级排序词语
+type byPriority struct {
+	entries    []Entry
+	priorities []int
+}
+
+func (b byPriority) Len() int           { return len(b.entries) }
+func (b byPriority) Less(i, j int) bool { return b.priorities[i] < b.priorities[j] }
+func (b byPriority) Swap(i, j int) {
+	b.entries[i], b.entries[j] = b.entries[j], b.entries[i]
+	b.priorities[i], b.priorities[j] = b.priorities[j], b.priorities[i]
+}
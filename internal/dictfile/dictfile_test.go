@@ -0,0 +1,149 @@
+package dictfile
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testEntries 测试用的词语, 超过三块, 包含共同前缀和跨块的前缀
+func testEntries() []Entry {
+	entries := []Entry{
+		{Word: "中", Syllables: []string{"zhong1"}},
+		{Word: "中国", Syllables: []string{"zhong1", "guo2"}},
+		{Word: "中国人", Syllables: []string{"zhong1", "guo2", "ren2"}},
+		{Word: "中国人民", Syllables: []string{"zhong1", "guo2", "ren2", "min2"}},
+		{Word: "中间", Syllables: []string{"zhong1", "jian1"}},
+		{Word: "重庆", Syllables: []string{"chong2", "qing4"}},
+		{Word: "重", Syllables: []string{"zhong4"}},
+		{Word: "a", Syllables: []string{"a1"}},
+	}
+	for i := 0; i < 3*blockSize; i++ {
+		entries = append(entries, Entry{Word: fmt.Sprintf("词%02d", i), Syllables: []string{"ci2", fmt.Sprintf("x%d", i%5)}})
+	}
+	return entries
+}
+
+func TestEncode_RoundTrip(t *testing.T) {
+	entries := testEntries()
+	data, err := Encode(entries)
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	d, err := Open(data)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	if d.Len() != len(entries) || d.MaxWordLen() != 4 {
+		t.Errorf("Len(), MaxWordLen() = %d, %d, want %d, 4", d.Len(), d.MaxWordLen(), len(entries))
+	}
+	if len(d.heads) < 3 {
+		t.Fatalf("encoded %d blocks, want at least 3", len(d.heads))
+	}
+	for i, e := range entries {
+		pos, priority, found, _ := d.Lookup(e.Word)
+		if !found || priority != i {
+			t.Errorf("Lookup(%q) = %v, %v, want true, %v", e.Word, found, priority, i)
+			continue
+		}
+		if got := d.AppendSyllables(nil, pos); !reflect.DeepEqual(got, e.Syllables) {
+			t.Errorf("AppendSyllables(%q) = %v, want %v", e.Word, got, e.Syllables)
+		}
+	}
+	if got := d.Entries(); !reflect.DeepEqual(got, entries) {
+		t.Errorf("Entries() = %v, want %v", got, entries)
+	}
+}
+
+func TestDict_Lookup(t *testing.T) {
+	entries := testEntries()
+	data, err := Encode(entries)
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	d, err := Open(data)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+
+	// 查找所有词语的每个字节前缀和一些不存在的词语, 结果与逐个比较的结果相同
+	queries := []string{"", "0", "b", "中中", "中国人民共和国", "词", "词0", "词1x", "词99", "重庆市", "\xff"}
+	for _, e := range entries {
+		for i := 1; i <= len(e.Word); i++ {
+			queries = append(queries, e.Word[:i])
+		}
+	}
+	for _, q := range queries {
+		var wantFound, wantLonger bool
+		for _, e := range entries {
+			wantFound = wantFound || e.Word == q
+			wantLonger = wantLonger || len(e.Word) > len(q) && strings.HasPrefix(e.Word, q)
+		}
+		if _, _, found, longer := d.Lookup(q); found != wantFound || longer != wantLonger {
+			t.Errorf("Lookup(%q) = %v, %v, want %v, %v", q, found, longer, wantFound, wantLonger)
+		}
+	}
+}
+
+func TestEncode_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []Entry
+	}{
+		{"empty_word", []Entry{{Word: "", Syllables: []string{"a1"}}}},
+		{"missing_reading", []Entry{{Word: "中"}}},
+		{"duplicate", []Entry{{Word: "中", Syllables: []string{"zhong1"}}, {Word: "中", Syllables: []string{"zhong4"}}}},
+		{"too_long", []Entry{{Word: strings.Repeat("a", maxKeyLen+1), Syllables: []string{"a1"}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Encode(tt.entries); err == nil {
+				t.Errorf("Encode() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestOpen_Corrupt(t *testing.T) {
+	entries := testEntries()
+	data, err := Encode(entries)
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	if _, err := Open([]byte("PYDICT\x02")); err == nil {
+		t.Errorf("Open() of another version error = nil, want error")
+	}
+	// 截断的数据返回错误, 或者查找时不会越界
+	for n := 0; n < len(data); n++ {
+		d, err := Open(data[:n])
+		if err != nil {
+			continue
+		}
+		for _, e := range entries {
+			if pos, _, found, _ := d.Lookup(e.Word); found {
+				d.AppendSyllables(nil, pos)
+			}
+		}
+		d.Entries()
+	}
+}
+
+func TestMerge(t *testing.T) {
+	entries := []Entry{
+		{Word: "中", Syllables: []string{"zhong1"}},
+		{Word: "中国", Syllables: []string{"zhong1", "guo2"}},
+		{Word: "重", Syllables: []string{"zhong4"}},
+	}
+	overrides := []Entry{
+		{Word: "重", Syllables: []string{"chong2"}},
+		{Word: "中国", Syllables: []string{Delete}},
+	}
+	want := []Entry{
+		{Word: "重", Syllables: []string{"chong2"}},
+		{Word: "中", Syllables: []string{"zhong1"}},
+	}
+	if got := Merge(entries, overrides); !reflect.DeepEqual(got, want) {
+		t.Errorf("Merge() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,34 @@
+package pinyin
+
+import (
+	_ "embed"
+	"sync"
+
+	"pinyin-golang/internal/dictfile"
+)
+
+// 设置 OVERTRUE_DATA 为 overtrue/pinyin 的数据目录时先导入词典, 写入 data/dict.txt
+//go:generate go run ../cmd/pinyin-dictgen -overtrue=$OVERTRUE_DATA -overrides data/overrides.txt -o dict.bin data/dict.txt
+
+// dictData 编译后的内置词典, 由 data/dict.txt 和 data/overrides.txt 生成, 修改后需要运行 go generate
+//
+//go:embed dict.bin
+var dictData []byte
+
+var (
+	builtinOnce sync.Once
+	// builtin 内置词典, 第一次转换时打开, 直接读取 dictData, 不复制到堆中
+	builtin *dictfile.Dict
+)
+
+// builtinDict 返回内置词典
+func builtinDict() *dictfile.Dict {
+	builtinOnce.Do(func() {
+		d, err := dictfile.Open(dictData)
+		if err != nil {
+			panic("pinyin: invalid builtin dictionary: " + err.Error())
+		}
+		builtin = d
+	})
+	return builtin
+}
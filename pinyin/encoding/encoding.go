@@ -0,0 +1,453 @@
+// Package encoding GB18030, GBK 和 Big5 (CP950) 文本的解码, 编码和检测
+// 词典只处理 UTF-8 文本, 其他编码的文本需要先解码; 编码表较大, 放在单独的包中, 只有使用时才会被链接
+package encoding
+
+// 设置 ENCODING_DATA 为 WHATWG Encoding Standard 的编码表目录时重新生成 tables.go
+//go:generate go run ../../cmd/pinyin-enctables -data=$ENCODING_DATA -o tables.go
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"pinyin-golang/pinyin"
+)
+
+// Encoding 文本的编码
+type Encoding int
+
+const (
+	// UTF8 UTF-8, 默认
+	UTF8 Encoding = iota
+	// GB18030 GB18030, 兼容 GBK 和 GB2312
+	GB18030
+	// GBK GBK, 按 GB18030 解码
+	GBK
+	// Big5 Big5 (CP950)
+	Big5
+)
+
+var (
+	// encodingNames 编码的名称, 第一个为规范名称
+	encodingNames = map[Encoding][]string{
+		UTF8:    {"utf-8", "utf8"},
+		GB18030: {"gb18030"},
+		GBK:     {"gbk", "gb2312", "cp936"},
+		Big5:    {"big5", "cp950"},
+	}
+
+	// utf8BOM UTF-8 的字节顺序标记
+	utf8BOM = []byte("\xef\xbb\xbf")
+
+	// gb18030Encoder, big5Encoder 字符到双字节编码的映射, 第一次编码时创建
+	gb18030Encoder, big5Encoder map[rune]uint16
+	encoderOnce                 sync.Once
+)
+
+const (
+	// gb18030Trails GB18030 双字节编码的尾字节个数, 0x40-0x7E, 0x80-0xFE
+	gb18030Trails = 190
+	// big5Trails Big5 双字节编码的尾字节个数, 0x40-0x7E, 0xA1-0xFE
+	big5Trails = 157
+	// gb18030MaxBMP 四字节编码中 BMP 字符的最大序号
+	gb18030MaxBMP = 39419
+	// gb18030Supplementary 四字节编码中 U+10000 的序号, 即 0x90308130
+	gb18030Supplementary = 189000
+)
+
+// String 编码的规范名称
+func (e Encoding) String() string {
+	if names, ok := encodingNames[e]; ok {
+		return names[0]
+	}
+	return fmt.Sprintf("Encoding(%d)", int(e))
+}
+
+// Parse 按名称查找编码, 不区分大小写, 如 utf-8, gbk, gb18030, big5
+func Parse(name string) (Encoding, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for e, names := range encodingNames {
+		for _, n := range names {
+			if n == name {
+				return e, nil
+			}
+		}
+	}
+	return UTF8, fmt.Errorf("encoding: unknown encoding %q", name)
+}
+
+// DecodeError 无效的字节序列
+type DecodeError struct {
+	// Encoding 输入的编码
+	Encoding Encoding
+	// Offsets 无效字节序列在输入中的位置
+	Offsets []int
+}
+
+func (e *DecodeError) Error() string {
+	if len(e.Offsets) == 1 {
+		return fmt.Sprintf("encoding: invalid %s byte sequence at offset %d", e.Encoding, e.Offsets[0])
+	}
+	return fmt.Sprintf("encoding: invalid %s byte sequence at offset %d (and %d more)", e.Encoding, e.Offsets[0], len(e.Offsets)-1)
+}
+
+// Decode 按编码将 b 解码为 UTF-8, 开头的 UTF-8 BOM 被去除
+// 无效的字节序列替换为 U+FFFD, 并返回记录了位置的 *DecodeError
+func Decode(b []byte, enc Encoding) (string, error) {
+	var builder strings.Builder
+	var offsets []int
+	start := 0
+	if enc == UTF8 && bytes.HasPrefix(b, utf8BOM) {
+		start = len(utf8BOM)
+	}
+	for i := start; i < len(b); {
+		r, size := decodeRune(b[i:], enc)
+		if r == utf8.RuneError && size <= 1 {
+			offsets = append(offsets, i)
+			size = 1
+		}
+		builder.WriteRune(r)
+		i += size
+	}
+	if len(offsets) > 0 {
+		return builder.String(), &DecodeError{Encoding: enc, Offsets: offsets}
+	}
+	return builder.String(), nil
+}
+
+// decodeRune 解码 b 开头的字符, 无效时返回 utf8.RuneError 和 1, b 不完整时返回 utf8.RuneError 和 0
+func decodeRune(b []byte, enc Encoding) (rune, int) {
+	if enc == UTF8 {
+		r, size := utf8.DecodeRune(b)
+		if r == utf8.RuneError && size == 1 && !utf8.FullRune(b) {
+			return utf8.RuneError, 0
+		}
+		return r, size
+	}
+
+	if len(b) == 0 {
+		return utf8.RuneError, 0
+	}
+	if b[0] < 0x80 {
+		return rune(b[0]), 1
+	}
+	if b[0] == 0x80 || b[0] == 0xFF {
+		return utf8.RuneError, 1
+	}
+	if len(b) < 2 {
+		return utf8.RuneError, 0
+	}
+
+	lead, trail := int(b[0])-0x81, int(b[1])
+	if enc == Big5 {
+		switch {
+		case trail >= 0x40 && trail <= 0x7E:
+			trail -= 0x40
+		case trail >= 0xA1 && trail <= 0xFE:
+			trail -= 0xA1 - 0x3F
+		default:
+			return utf8.RuneError, 1
+		}
+		if r := big5Table[lead*big5Trails+trail]; r != 0 {
+			return rune(r), 2
+		}
+		return utf8.RuneError, 1
+	}
+
+	switch {
+	case trail >= 0x40 && trail <= 0x7E:
+		trail -= 0x40
+	case trail >= 0x80 && trail <= 0xFE:
+		trail -= 0x41
+	case trail >= 0x30 && trail <= 0x39 && enc == GB18030:
+		return decodeFourBytes(b)
+	default:
+		return utf8.RuneError, 1
+	}
+	if r := gb18030Table[lead*gb18030Trails+trail]; r != 0 {
+		return rune(r), 2
+	}
+	return utf8.RuneError, 1
+}
+
+// decodeFourBytes 解码 GB18030 的四字节编码
+func decodeFourBytes(b []byte) (rune, int) {
+	if len(b) < 4 {
+		return utf8.RuneError, 0
+	}
+	if b[2] < 0x81 || b[2] > 0xFE || b[3] < 0x30 || b[3] > 0x39 {
+		return utf8.RuneError, 1
+	}
+	linear := (((int(b[0])-0x81)*10+int(b[1])-0x30)*126+int(b[2])-0x81)*10 + int(b[3]) - 0x30
+	if linear <= gb18030MaxBMP {
+		i := sort.Search(len(gb18030Ranges), func(i int) bool { return int(gb18030Ranges[i][0]) > linear }) - 1
+		return rune(int(gb18030Ranges[i][1]) + linear - int(gb18030Ranges[i][0])), 4
+	}
+	if r := rune(0x10000 + linear - gb18030Supplementary); linear >= gb18030Supplementary && r <= utf8.MaxRune {
+		return r, 4
+	}
+	return utf8.RuneError, 1
+}
+
+// EncodeError 无法编码的字符
+type EncodeError struct {
+	// Encoding 输出的编码
+	Encoding Encoding
+	// Rune 无法编码的字符
+	Rune rune
+	// Offset 字符在输入中的位置
+	Offset int
+}
+
+func (e *EncodeError) Error() string {
+	return fmt.Sprintf("encoding: %q at offset %d cannot be encoded in %s", e.Rune, e.Offset, e.Encoding)
+}
+
+// Encode 将 UTF-8 字符串按编码输出, 遇到无法编码的字符时返回 *EncodeError
+func Encode(s string, enc Encoding) ([]byte, error) {
+	if enc == UTF8 {
+		return []byte(s), nil
+	}
+	encoderOnce.Do(buildEncoders)
+
+	b := make([]byte, 0, len(s))
+	for i, r := range s {
+		if r < 0x80 {
+			b = append(b, byte(r))
+			continue
+		}
+		if enc == Big5 {
+			code, ok := big5Encoder[r]
+			if !ok {
+				return nil, &EncodeError{Encoding: enc, Rune: r, Offset: i}
+			}
+			b = append(b, byte(code>>8), byte(code))
+			continue
+		}
+		if code, ok := gb18030Encoder[r]; ok {
+			b = append(b, byte(code>>8), byte(code))
+			continue
+		}
+		if enc == GBK {
+			return nil, &EncodeError{Encoding: enc, Rune: r, Offset: i}
+		}
+		b = append(b, encodeFourBytes(r)...)
+	}
+	return b, nil
+}
+
+// buildEncoders 由解码表创建双字节编码的映射
+func buildEncoders() {
+	gb18030Encoder = make(map[rune]uint16, len(gb18030Table))
+	for i, r := range gb18030Table {
+		if r != 0 {
+			trail := i%gb18030Trails + 0x40
+			if trail >= 0x7F {
+				trail++
+			}
+			gb18030Encoder[rune(r)] = uint16((i/gb18030Trails+0x81)<<8 | trail)
+		}
+	}
+	big5Encoder = make(map[rune]uint16, len(big5Table))
+	for i, r := range big5Table {
+		if _, ok := big5Encoder[rune(r)]; r != 0 && !ok {
+			trail := i%big5Trails + 0x40
+			if trail > 0x7E {
+				trail += 0xA1 - 0x7F
+			}
+			big5Encoder[rune(r)] = uint16((i/big5Trails+0x81)<<8 | trail)
+		}
+	}
+}
+
+// encodeFourBytes 按 GB18030 的四字节编码输出双字节编码以外的字符
+func encodeFourBytes(r rune) []byte {
+	var linear int
+	if r >= 0x10000 {
+		linear = int(r) - 0x10000 + gb18030Supplementary
+	} else {
+		i := sort.Search(len(gb18030Ranges), func(i int) bool { return rune(gb18030Ranges[i][1]) > r }) - 1
+		linear = int(gb18030Ranges[i][0]) + int(r) - int(gb18030Ranges[i][1])
+	}
+	b4 := linear % 10
+	linear /= 10
+	b3 := linear % 126
+	linear /= 126
+	b2 := linear % 10
+	b1 := linear / 10
+	return []byte{byte(b1 + 0x81), byte(b2 + 0x30), byte(b3 + 0x81), byte(b4 + 0x30)}
+}
+
+// Detect 检测文本的编码
+// 有效的 UTF-8 (包括带 BOM 的) 视为 UTF-8, 否则按 GB18030 和 Big5 解码, 选择无效字节较少, 有读音的汉字和词典中的词语较多的编码
+// b 可以是文件的开头, 结尾不完整的 UTF-8 字符不影响检测
+func Detect(b []byte) Encoding {
+	if utf8.Valid(trimIncompleteRune(b)) {
+		return UTF8
+	}
+	dict := pinyin.NewDict()
+	best, bestScore := GB18030, 0
+	for i, enc := range []Encoding{GB18030, Big5} {
+		s, err := Decode(b, enc)
+		score := 0
+		for _, token := range dict.Tokens(s) {
+			if token.Syllables == nil {
+				continue
+			}
+			// 有读音的汉字计 1 分, 词语中的汉字再计 1 分
+			n := len(token.Syllables)
+			score += n
+			if n > 1 {
+				score += n
+			}
+		}
+		if err != nil {
+			score -= 10 * len(err.(*DecodeError).Offsets)
+		}
+		if i == 0 || score > bestScore {
+			best, bestScore = enc, score
+		}
+	}
+	return best
+}
+
+// trimIncompleteRune 去掉结尾被截断的 UTF-8 字符, 最多 3 个字节
+func trimIncompleteRune(b []byte) []byte {
+	for i := len(b) - 1; i >= 0 && i >= len(b)-utf8.UTFMax+1; i-- {
+		if utf8.RuneStart(b[i]) {
+			if !utf8.FullRune(b[i:]) {
+				return b[:i]
+			}
+			break
+		}
+	}
+	return b
+}
+
+// Decoder 按编码读取文本并输出 UTF-8, 用于流式处理很大的文件
+type Decoder struct {
+	r   io.Reader
+	enc Encoding
+	// buf 未解码的字节, 可能包含不完整的字符
+	buf []byte
+	// out 已解码未读取的内容
+	out []byte
+	// offset buf 开头在输入中的位置
+	offset  int
+	eof     bool
+	bom     bool
+	offsets []int
+}
+
+// NewDecoder 创建解码器, 开头的 UTF-8 BOM 被去除
+// 无效的字节序列替换为 U+FFFD, 读取完毕后由 Err 返回
+func NewDecoder(r io.Reader, enc Encoding) *Decoder {
+	return &Decoder{r: r, enc: enc, bom: enc == UTF8}
+}
+
+// Read 实现 io.Reader
+func (d *Decoder) Read(p []byte) (int, error) {
+	for len(d.out) == 0 {
+		if d.eof && len(d.buf) == 0 {
+			return 0, io.EOF
+		}
+		if !d.eof {
+			chunk := make([]byte, 32*1024)
+			n, err := d.r.Read(chunk)
+			d.buf = append(d.buf, chunk[:n]...)
+			if err == io.EOF {
+				d.eof = true
+			} else if err != nil {
+				return 0, err
+			}
+		}
+		d.decode()
+	}
+	n := copy(p, d.out)
+	d.out = d.out[n:]
+	return n, nil
+}
+
+// decode 解码 buf 中完整的字符
+func (d *Decoder) decode() {
+	if d.bom {
+		if len(d.buf) < len(utf8BOM) && !d.eof && bytes.HasPrefix(utf8BOM, d.buf) {
+			return
+		}
+		if bytes.HasPrefix(d.buf, utf8BOM) {
+			d.buf, d.offset = d.buf[len(utf8BOM):], len(utf8BOM)
+		}
+		d.bom = false
+	}
+
+	var rb [utf8.UTFMax]byte
+	i := 0
+	for i < len(d.buf) {
+		r, size := decodeRune(d.buf[i:], d.enc)
+		if size == 0 {
+			if !d.eof {
+				break
+			}
+			size = 1
+		}
+		if r == utf8.RuneError && size == 1 {
+			d.offsets = append(d.offsets, d.offset+i)
+		}
+		d.out = append(d.out, rb[:utf8.EncodeRune(rb[:], r)]...)
+		i += size
+	}
+	d.buf = append(d.buf[:0], d.buf[i:]...)
+	d.offset += i
+}
+
+// Err 返回已读取内容中的无效字节序列, 没有时返回 nil
+func (d *Decoder) Err() error {
+	if len(d.offsets) == 0 {
+		return nil
+	}
+	return &DecodeError{Encoding: d.enc, Offsets: d.offsets}
+}
+
+// Encoder 将 UTF-8 文本按编码写入 w
+type Encoder struct {
+	w   io.Writer
+	enc Encoding
+	// tail 上次写入末尾不完整的 UTF-8 字符
+	tail []byte
+	// offset 已写入的 UTF-8 字节数
+	offset int
+}
+
+// NewEncoder 创建编码器, 遇到无法编码的字符时 Write 返回 *EncodeError
+func NewEncoder(w io.Writer, enc Encoding) *Encoder {
+	return &Encoder{w: w, enc: enc}
+}
+
+// Write 实现 io.Writer, p 末尾不完整的 UTF-8 字符与下次写入的内容一起编码
+func (e *Encoder) Write(p []byte) (int, error) {
+	b := append(e.tail, p...)
+	n := len(b)
+	for i := len(b) - 1; i >= 0 && i >= len(b)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(b[i]) {
+			if !utf8.FullRune(b[i:]) {
+				n = i
+			}
+			break
+		}
+	}
+	encoded, err := Encode(string(b[:n]), e.enc)
+	if err != nil {
+		err.(*EncodeError).Offset += e.offset
+		return 0, err
+	}
+	if _, err := e.w.Write(encoded); err != nil {
+		return 0, err
+	}
+	e.offset += n
+	e.tail = append([]byte(nil), b[n:]...)
+	return len(p), nil
+}
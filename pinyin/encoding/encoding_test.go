@@ -0,0 +1,133 @@
+package encoding
+
+import (
+	"io"
+	"testing"
+)
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name string
+		b    string
+		enc  Encoding
+		want string
+	}{
+		{"gbk", "\xd6\xd0\xce\xc4", GBK, "中文"},
+		{"gb18030", "\xd6\xd0\xa2\xe3\x94\x39\xfc\x36\x81\x30\xb6\x33", GB18030, "中€😀ʼ"},
+		{"big5", "\xa4\xa4\xa4\xe5", Big5, "中文"},
+		{"utf8_bom", "\xef\xbb\xbf中文", UTF8, "中文"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Decode([]byte(tt.b), tt.enc)
+			if err != nil || got != tt.want {
+				t.Errorf("Decode() = %q, %v, want %q", got, err, tt.want)
+			}
+			if tt.enc == UTF8 {
+				return
+			}
+			b, err := Encode(got, tt.enc)
+			if err != nil || string(b) != tt.b {
+				t.Errorf("Encode() = %x, %v, want %x", b, err, tt.b)
+			}
+		})
+	}
+}
+
+func TestDecode_Error(t *testing.T) {
+	got, err := Decode([]byte("\xd6\xd0\xff\xce\xc4\x81"), GBK)
+	if got != "中�文�" {
+		t.Errorf("Decode() = %q, want %q", got, "中�文�")
+	}
+	e, ok := err.(*DecodeError)
+	if !ok || len(e.Offsets) != 2 || e.Offsets[0] != 2 || e.Offsets[1] != 5 {
+		t.Errorf("Decode() error = %v, want offsets [2 5]", err)
+	}
+	if _, err := Encode("中文😀", GBK); err == nil {
+		t.Errorf("Encode() error = nil, want *EncodeError")
+	}
+}
+
+func TestEncode_RoundTrip(t *testing.T) {
+	for _, enc := range []Encoding{GB18030, Big5} {
+		var runes []rune
+		for r := rune(0x4e00); r <= 0x9fa5; r++ {
+			runes = append(runes, r)
+		}
+		s := string(runes)
+		if enc == Big5 {
+			s = "中華人民共和國銀行很便宜，音樂"
+		}
+		b, err := Encode(s, enc)
+		if err != nil {
+			t.Fatalf("Encode(%v) error = %v", enc, err)
+		}
+		if got, err := Decode(b, enc); err != nil || got != s {
+			t.Errorf("Decode(Encode(%v)) error = %v, round trip mismatch", enc, err)
+		}
+	}
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		s    string
+		want Encoding
+	}{
+		{"中华人民共和国银行很便宜", UTF8},
+		{"\xd6\xd0\xbb\xaa\xc8\xcb\xc3\xf1\xb9\xb2\xba\xcd\xb9\xfa", GB18030},
+		{"\xa4\xa4\xb5\xd8\xa4\x48\xa5\xc1\xa6\x40\xa9\x4d\xb0\xea", Big5},
+		// 只读取文件开头时最后一个字符可能被截断
+		{"中华人民共和国"[:len("中华人民共和国")-1], UTF8},
+		{"中华人民共和国"[:len("中华人民共和国")-2], UTF8},
+	}
+	for _, tt := range tests {
+		if got := Detect([]byte(tt.s)); got != tt.want {
+			t.Errorf("Detect(%x) = %v, want %v", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestDecoder(t *testing.T) {
+	b := []byte("\xef\xbb\xbf中文\xff拼音")
+	d := NewDecoder(&oneByteReader{b}, UTF8)
+	var got []byte
+	buf := make([]byte, 2)
+	for {
+		n, err := d.Read(buf)
+		got = append(got, buf[:n]...)
+		if err != nil {
+			break
+		}
+	}
+	if string(got) != "中文�拼音" {
+		t.Errorf("Decoder.Read() = %q, want %q", got, "中文�拼音")
+	}
+	if e, ok := d.Err().(*DecodeError); !ok || len(e.Offsets) != 1 || e.Offsets[0] != 9 {
+		t.Errorf("Decoder.Err() = %v, want offset 9", d.Err())
+	}
+}
+
+// oneByteReader 每次只读取一个字节
+type oneByteReader struct {
+	b []byte
+}
+
+func (r *oneByteReader) Read(p []byte) (int, error) {
+	if len(r.b) == 0 {
+		return 0, io.EOF
+	}
+	p[0] = r.b[0]
+	r.b = r.b[1:]
+	return 1, nil
+}
+
+// benchText 基准测试使用的文本
+const benchText = `带着希望去旅行，比到达终点更美好。我爱北京天安门, iPhone 15 发布会在 2024 年举行！`
+
+func BenchmarkDecode(b *testing.B) {
+	data, _ := Encode(benchText, GB18030)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Decode(data, GB18030)
+	}
+}
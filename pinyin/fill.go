@@ -0,0 +1,214 @@
+package pinyin
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// fillTag 解析后的 pinyin 标签
+type fillTag struct {
+	// from 转换的字段
+	from string
+	// mode 转换模式: convert, sentence, name
+	mode string
+	// abbr 只输出每个音节的首字母
+	abbr bool
+	// style 声调: ascii, unicode, none
+	style string
+	// sep 音节之间的分隔符
+	sep string
+	// v ü 的写法
+	v VStyle
+}
+
+// fillVStyles 标签中 v 的取值
+var fillVStyles = map[string]VStyle{
+	"v": VStyleV, "umlaut": VStyleUmlaut, "colon": VStyleColon, "yu": VStyleYu, "u": VStyleU,
+}
+
+// parseFillTag 解析 pinyin 标签, 如 "from=Name,style=none,sep= " 或 "from=Name,abbr"
+func parseFillTag(tag string) (*fillTag, error) {
+	t := &fillTag{mode: "convert", style: "unicode"}
+	var sep *string
+	for _, item := range strings.Split(tag, ",") {
+		key, value := item, ""
+		if i := strings.IndexByte(item, '='); i >= 0 {
+			key, value = item[:i], item[i+1:]
+		}
+		switch key {
+		case "from":
+			t.from = value
+		case "mode":
+			t.mode = value
+		case "convert", "sentence", "name":
+			t.mode = key
+		case "abbr":
+			t.abbr = true
+		case "style":
+			t.style = value
+		case "sep":
+			sep = &value
+		case "v":
+			v, ok := fillVStyles[value]
+			if !ok {
+				return nil, fmt.Errorf("unknown ü style %q", value)
+			}
+			t.v = v
+		default:
+			return nil, fmt.Errorf("unknown option %q", item)
+		}
+	}
+
+	if t.from == "" {
+		return nil, fmt.Errorf("missing from")
+	}
+	if t.mode == "abbr" {
+		t.mode, t.abbr = "convert", true
+	}
+	switch t.mode {
+	case "convert", "sentence", "name":
+	default:
+		return nil, fmt.Errorf("unknown mode %q", t.mode)
+	}
+	switch t.style {
+	case "ascii", "unicode", "none":
+	default:
+		return nil, fmt.Errorf("unknown tone style %q", t.style)
+	}
+	// 首字母默认不加分隔符, 如 zs
+	t.sep = " "
+	if t.abbr {
+		t.sep = ""
+	}
+	if sep != nil {
+		t.sep = *sep
+	}
+	return t, nil
+}
+
+// convert 按标签转换文本
+func (t *fillTag) convert(p *Dict, s string) string {
+	var result *ConvertResult
+	switch t.mode {
+	case "sentence":
+		result = p.Sentence(s)
+	case "name":
+		result = p.Name(s, t.sep)
+	default:
+		result = p.Convert(s, t.sep)
+	}
+	if t.abbr {
+		return result.abbr(t.sep)
+	}
+
+	var opts []Option
+	if t.v != "" {
+		opts = append(opts, WithVStyle(t.v))
+	}
+	switch t.style {
+	case "ascii":
+		return result.ASCII(opts...)
+	case "none":
+		return result.None(opts...)
+	}
+	return result.Unicode(opts...)
+}
+
+// Fill 使用默认的词典按 pinyin 标签填充结构体中的拼音字段, 见 Dict.Fill
+func Fill(v interface{}) error {
+	return NewDict().Fill(v)
+}
+
+// Fill 按 pinyin 标签填充结构体中的拼音字段, v 必须是非 nil 的指针
+// 标签的选项以逗号分隔:
+//
+//	from=Name   转换的字段, 必须是同一个结构体中的 string 字段
+//	mode=name   转换模式: convert (默认), sentence, name, 也可以简写为 name 等
+//	abbr        只输出每个音节的首字母, 与 name 一起使用时按人名的读音, 如 单雄信 → sxx
+//	style=none  声调: ascii, unicode (默认), none
+//	sep=-       音节之间的分隔符, 默认为空格, abbr 默认没有分隔符
+//	v=v         ü 的写法: v, umlaut, colon, yu, u
+//
+// 嵌套的结构体, 结构体指针, slice 和数组中的结构体也会被填充, map 中的值不能修改, 不会填充
+//
+//	type User struct {
+//		Name       string
+//		NamePinyin string `pinyin:"from=Name,name,style=none"`
+//		NameAbbr   string `pinyin:"from=Name,name,abbr"`
+//	}
+func (p *Dict) Fill(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("pinyin: Fill requires a non-nil pointer, got %T", v)
+	}
+	return p.fill(rv, map[fillKey]bool{})
+}
+
+// fillKey 已经填充的指针, 结构体与它的第一个字段地址相同, 需要同时比较类型
+type fillKey struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+// fill 递归填充, visited 记录已经填充的指针, 避免循环引用
+func (p *Dict) fill(v reflect.Value, visited map[fillKey]bool) error {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil
+		}
+		key := fillKey{ptr: v.Pointer(), typ: v.Type()}
+		if visited[key] {
+			return nil
+		}
+		visited[key] = true
+		return p.fill(v.Elem(), visited)
+	case reflect.Interface:
+		// 只有接口中的指针可以修改
+		if !v.IsNil() && v.Elem().Kind() == reflect.Ptr {
+			return p.fill(v.Elem(), visited)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := p.fill(v.Index(i), visited); err != nil {
+				return err
+			}
+		}
+	case reflect.Struct:
+		return p.fillStruct(v, visited)
+	}
+	return nil
+}
+
+// fillStruct 填充结构体中带有标签的字段, 再递归填充其他字段
+func (p *Dict) fillStruct(v reflect.Value, visited map[fillKey]bool) error {
+	typ := v.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		tag, ok := field.Tag.Lookup("pinyin")
+		if !ok {
+			if field.PkgPath == "" {
+				if err := p.fill(v.Field(i), visited); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		t, err := parseFillTag(tag)
+		if err != nil {
+			return fmt.Errorf("pinyin: %s.%s: %s", typ, field.Name, err)
+		}
+		target := v.Field(i)
+		if !target.CanSet() || target.Kind() != reflect.String {
+			return fmt.Errorf("pinyin: %s.%s: target must be an exported string field", typ, field.Name)
+		}
+		source := v.FieldByName(t.from)
+		if !source.IsValid() || source.Kind() != reflect.String {
+			return fmt.Errorf("pinyin: %s.%s: from field %q must be a string field", typ, field.Name, t.from)
+		}
+		target.SetString(t.convert(p, source.String()))
+	}
+	return nil
+}
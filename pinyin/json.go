@@ -0,0 +1,93 @@
+package pinyin
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// ResultVersion 转换结果 JSON 格式的版本
+// 添加字段时版本不变, 删除字段或改变字段的含义时增加版本
+const ResultVersion = 1
+
+// ResultJSON 转换结果的 JSON 格式
+type ResultJSON struct {
+	// Version 格式的版本, 即 ResultVersion
+	Version int `json:"version"`
+	// Input 转换前的文本, 不是由 Dict 转换的结果为空
+	Input string `json:"input"`
+	// Pinyin 三种声调的转换结果
+	Pinyin PinyinJSON `json:"pinyin"`
+	// Abbr 每个音节的首字母, 没有分隔符
+	Abbr string `json:"abbr"`
+	// Words 分词结果, 不是由 Dict 转换的结果为空数组
+	Words []WordJSON `json:"words"`
+}
+
+// PinyinJSON 三种声调的拼音
+type PinyinJSON struct {
+	ASCII   string `json:"ascii"`
+	Unicode string `json:"unicode"`
+	None    string `json:"none"`
+}
+
+// WordJSON 分词结果中的词语
+type WordJSON struct {
+	// Text 词语的原文, 全角字符和数字已按词典的设置处理
+	Text string `json:"text"`
+	// Syllables 每个汉字的拼音, 不是汉字的内容省略
+	Syllables []SyllableJSON `json:"syllables,omitempty"`
+}
+
+// SyllableJSON 单个汉字的拼音
+type SyllableJSON struct {
+	// Char 汉字, 词语的字数与音节数不同时为空
+	Char string `json:"char"`
+	PinyinJSON
+}
+
+// JSON 返回转换结果的 JSON 格式
+// 重庆 → {"version":1,"input":"重庆","pinyin":{"ascii":"chong2 qing4",...},"abbr":"cq","words":[{"text":"重庆","syllables":[{"char":"重","ascii":"chong2","unicode":"chóng","none":"chong"},...]}]}
+func (r *ConvertResult) JSON() ResultJSON {
+	result := ResultJSON{
+		Version: ResultVersion,
+		Input:   r.input,
+		Pinyin:  PinyinJSON{ASCII: r.ASCII(), Unicode: r.Unicode(), None: r.None()},
+		Words:   []WordJSON{},
+	}
+
+	result.Abbr = r.abbr("")
+
+	for _, w := range r.words {
+		// prepare 在字母和数字前插入的分隔符
+		text := strings.Replace(w.text, "\t", "", -1)
+		if text == "" {
+			continue
+		}
+		item := WordJSON{Text: text}
+		chars := strings.Split(text, "")
+		if len(chars) != len(w.syllables) {
+			chars = make([]string, len(w.syllables))
+		}
+		for i, syllable := range w.syllables {
+			if syl, ok := tokenSyllable(syllable); ok {
+				item.Syllables = append(item.Syllables, SyllableJSON{
+					Char:       chars[i],
+					PinyinJSON: PinyinJSON{ASCII: syl.ASCII(), Unicode: syl.Unicode(), None: syl.None()},
+				})
+			}
+		}
+		result.Words = append(result.Words, item)
+	}
+	return result
+}
+
+// MarshalJSON 实现 json.Marshaler, 输出 JSON 方法返回的结构
+// 使用值接收者, ConvertResult 和 *ConvertResult 的输出相同
+func (r ConvertResult) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.JSON())
+}
+
+// MarshalText 实现 encoding.TextMarshaler, 输出 Unicode 声调的拼音
+func (r ConvertResult) MarshalText() ([]byte, error) {
+	return []byte(r.Unicode()), nil
+}
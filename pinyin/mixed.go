@@ -0,0 +1,135 @@
+package pinyin
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MixedOption Mixed 的选项
+type MixedOption func(*mixedOptions)
+
+// mixedOptions Mixed 的选项
+type mixedOptions struct {
+	// boundary 汉字和其他文字交界处的分隔符
+	boundary string
+	// drop 去除的文字
+	drop []*unicode.RangeTable
+	// keep 只保留的文字, 为空时保留所有文字
+	keep []*unicode.RangeTable
+}
+
+// WithBoundary 汉字和其他文字交界处的分隔符, 默认为空格
+func WithBoundary(boundary string) MixedOption {
+	return func(o *mixedOptions) {
+		o.boundary = boundary
+	}
+}
+
+// WithDropScripts 去除这些文字的内容, 如 unicode.Latin, unicode.Hiragana
+func WithDropScripts(scripts ...*unicode.RangeTable) MixedOption {
+	return func(o *mixedOptions) {
+		o.drop = append(o.drop, scripts...)
+	}
+}
+
+// WithKeepScripts 只保留这些文字的内容, 其余的非汉字内容被去除, 如 unicode.Latin, unicode.Common
+func WithKeepScripts(scripts ...*unicode.RangeTable) MixedOption {
+	return func(o *mixedOptions) {
+		o.keep = append(o.keep, scripts...)
+	}
+}
+
+// keepRune 是否保留非汉字的字符, 空白总是保留
+func (o *mixedOptions) keepRune(r rune) bool {
+	if unicode.IsSpace(r) {
+		return true
+	}
+	for _, script := range o.drop {
+		if unicode.Is(script, r) {
+			return false
+		}
+	}
+	if len(o.keep) == 0 {
+		return true
+	}
+	for _, script := range o.keep {
+		if unicode.Is(script, r) {
+			return true
+		}
+	}
+	return false
+}
+
+// Mixed 转换中文和其他文字混合的文本, 只转换汉字, 其他内容和空白按原样保留
+// 音节之间使用 sep 分隔, 汉字和其他文字的交界处恰好有一个分隔符: 原文在交界处有空白时保留空白, 否则插入 WithBoundary 指定的分隔符
+// 标点符号与拼音之间不加分隔符
+// café很好 → café hěn hǎo, 第3名 → dì 3 míng, 你好，世界 → nǐ hǎo，shì jiè
+func (p *Dict) Mixed(s string, sep string, opts ...MixedOption) (result *ConvertResult) {
+	o := &mixedOptions{boundary: " "}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	input := s
+	builder := &marked{}
+	var words []word
+	// last 最后输出的字符, 拼音为 0; han 最后输出的是否为汉字的拼音
+	var last rune
+	han := false
+	for len(s) > 0 {
+		n := hanRunLen(s)
+		if n > 0 {
+			if len(builder.buf) > 0 && needsSeparator(last) {
+				builder.writeString(o.boundary)
+			}
+			hanWords := p.segment(s[:n])
+			words = append(words, hanWords...)
+			for i, w := range hanWords {
+				if i > 0 {
+					builder.writeString(sep)
+				}
+				if w.syllables == nil {
+					builder.writeString(w.text)
+					continue
+				}
+				for j, syllable := range w.syllables {
+					if j > 0 {
+						builder.writeString(sep)
+					}
+					builder.writeSyllable(syllable, false)
+				}
+			}
+			s = s[n:]
+			last, han = 0, true
+			continue
+		}
+
+		n = len(s) - len(strings.TrimLeftFunc(s, func(r rune) bool { return !unicode.Is(unicode.Han, r) }))
+		text := strings.Map(func(r rune) rune {
+			if o.keepRune(r) {
+				return r
+			}
+			return -1
+		}, s[:n])
+		s = s[n:]
+		if text == "" {
+			continue
+		}
+		words = append(words, word{text: text})
+		if first, _ := utf8.DecodeRuneInString(text); han && needsSeparator(first) {
+			builder.writeString(o.boundary)
+		}
+		builder.writeString(text)
+		last, _ = utf8.DecodeLastRuneInString(text)
+		han = false
+	}
+
+	result = builder.result(input, words)
+	return
+}
+
+// hanRunLen 开头连续汉字的字节数
+func hanRunLen(s string) int {
+	return len(s) - len(strings.TrimLeftFunc(s, func(r rune) bool { return unicode.Is(unicode.Han, r) }))
+}
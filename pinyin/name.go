@@ -0,0 +1,197 @@
+package pinyin
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	// compoundSurnames 复姓, 读音按 surnames 和词典转换
+	compoundSurnames = []string{
+		"欧阳", "太史", "端木", "上官", "司马", "东方", "独孤", "南宫", "万俟", "闻人",
+		"夏侯", "诸葛", "尉迟", "公羊", "赫连", "澹台", "皇甫", "宗政", "濮阳", "公冶",
+		"太叔", "申屠", "公孙", "慕容", "仲孙", "钟离", "长孙", "宇文", "司徒", "鲜于",
+		"司空", "闾丘", "子车", "亓官", "司寇", "巫马", "公西", "颛孙", "壤驷", "公良",
+		"漆雕", "乐正", "宰父", "谷梁", "拓跋", "夹谷", "轩辕", "令狐", "段干", "百里",
+		"呼延", "东郭", "南门", "羊舌", "微生", "公户", "公玉", "公仪", "梁丘", "公仲",
+		"公上", "公门", "公山", "公坚", "左丘", "公伯", "西门", "公祖", "第五", "公乘",
+		"贯丘", "公皙", "南荣", "东里", "东宫", "仲长", "子书", "子桑", "即墨", "达奚",
+		"褚师", "单于",
+	}
+
+	// surnameTable 姓氏的读音
+	surnameTable = newTable(surnames)
+	// givenNameTable 名字的读音
+	givenNameTable = newTable(givenNames)
+	// compoundSurnameSet 复姓集合
+	compoundSurnameSet = map[string]bool{}
+)
+
+func init() {
+	for i := 0; i < len(surnames); i += 2 {
+		if len([]rune(surnames[i])) > 1 {
+			compoundSurnameSet[surnames[i]] = true
+		}
+	}
+	for _, surname := range compoundSurnames {
+		compoundSurnameSet[surname] = true
+	}
+}
+
+// NameResult 人名转换结果
+type NameResult struct {
+	// Name 人名原文
+	Name string
+	// Surname 姓氏原文
+	Surname string
+	// GivenName 名字原文
+	GivenName string
+	// SurnamePinyin 姓氏拼音
+	SurnamePinyin *ConvertResult
+	// GivenNamePinyin 名字拼音
+	GivenNamePinyin *ConvertResult
+}
+
+// AddSurnames 添加姓氏的读音, 优先于内置的姓氏表, 如 覃 → qin2, 两个字的姓氏视为复姓
+// 不能与转换同时调用
+func (p *Dict) AddSurnames(words map[string]string) {
+	if p.surnames == nil {
+		p.surnames = newTable(nil)
+	}
+	for word, reading := range words {
+		p.surnames.add(word, reading)
+	}
+}
+
+// AddGivenNames 添加名字的读音, 优先于内置的名字表和词典, 如 朴 → piao2
+// 不能与转换同时调用
+func (p *Dict) AddGivenNames(words map[string]string) {
+	if p.givenNames == nil {
+		p.givenNames = newTable(nil)
+	}
+	for word, reading := range words {
+		p.givenNames.add(word, reading)
+	}
+}
+
+// Names 转换人名列表, 如 张三、单雄信、区志强
+// 分别返回每个人名的姓氏和名字, 姓氏使用姓氏表中的读音, 名字优先使用名字表中的读音
+func (p *Dict) Names(s string, sep string) (result []*NameResult) {
+	for _, name := range strings.FieldsFunc(s, isNameSeparator) {
+		surname, givenName := p.splitName(name)
+		result = append(result, &NameResult{
+			Name:            name,
+			Surname:         surname,
+			GivenName:       givenName,
+			SurnamePinyin:   wordsResult([]word{p.surnameWord(surname)}, sep),
+			GivenNamePinyin: wordsResult(p.segmentGivenName(givenName), sep),
+		})
+	}
+	return
+}
+
+// splitName 拆分姓氏和名字, 复姓优先, 否则第一个汉字为姓氏
+func (p *Dict) splitName(name string) (surname, givenName string) {
+	runes := []rune(name)
+	if len(runes) == 0 || !unicode.Is(unicode.Han, runes[0]) {
+		return "", name
+	}
+	if len(runes) > 2 && p.isCompoundSurname(string(runes[:2])) {
+		return string(runes[:2]), string(runes[2:])
+	}
+	return string(runes[:1]), string(runes[1:])
+}
+
+// isCompoundSurname 是否为复姓
+func (p *Dict) isCompoundSurname(s string) bool {
+	if _, ok := p.surnames.lookup(s); ok {
+		return true
+	}
+	return compoundSurnameSet[s]
+}
+
+// lookupSurname 查找姓氏的读音, 用户添加的姓氏优先
+func (p *Dict) lookupSurname(s string) (string, bool) {
+	if reading, ok := p.surnames.lookup(s); ok {
+		return reading, true
+	}
+	return surnameTable.lookup(s)
+}
+
+// surnameWord 姓氏的分词结果
+// 优先使用姓氏表中的读音, 复姓逐字查找姓氏表, 其余按词典转换
+func (p *Dict) surnameWord(surname string) word {
+	w := word{text: surname}
+	if reading, ok := p.lookupSurname(surname); ok {
+		w.syllables = strings.Fields(reading)
+		return w
+	}
+	for _, r := range surname {
+		if reading, ok := p.lookupSurname(string(r)); ok {
+			w.syllables = append(w.syllables, strings.Fields(reading)...)
+			continue
+		}
+		for _, item := range p.segment(string(r)) {
+			if item.syllables == nil {
+				return word{text: surname}
+			}
+			w.syllables = append(w.syllables, item.syllables...)
+		}
+	}
+	return w
+}
+
+// segmentGivenName 名字的分词结果, 用户添加的名字优先于词典
+// 词典中的多字词优先于内置的名字表, 内置的名字表只用于单字
+func (p *Dict) segmentGivenName(givenName string) []word {
+	words := p.segment(givenName, p.givenNames)
+	for i, w := range words {
+		if w.syllables == nil || utf8.RuneCountInString(w.text) != 1 {
+			continue
+		}
+		if _, ok := p.givenNames.lookup(w.text); ok {
+			continue
+		}
+		if reading, ok := givenNameTable.lookup(w.text); ok {
+			words[i].syllables = strings.Fields(reading)
+		}
+	}
+	return words
+}
+
+// wordsResult 由分词结果创建转换结果对象, 忽略没有读音的内容
+func wordsResult(words []word, sep string) *ConvertResult {
+	m := &marked{}
+	var input strings.Builder
+	for _, w := range words {
+		input.WriteString(w.text)
+		for _, syllable := range w.syllables {
+			if len(m.spans) > 0 {
+				m.writeString(sep)
+			}
+			m.writeSyllable(syllable, false)
+		}
+	}
+	return m.result(input.String(), words)
+}
+
+// segmentName 按一个人名分词, 开头的姓氏使用姓氏表中的读音, 名字优先使用名字表中的读音
+// 人名列表使用 Names 拆分
+func (p *Dict) segmentName(s string) []word {
+	surname, givenName := p.splitName(s)
+	var words []word
+	if surname != "" {
+		words = append(words, p.surnameWord(surname))
+	}
+	return append(words, p.segmentGivenName(givenName)...)
+}
+
+// isNameSeparator 是否为人名列表的分隔符
+func isNameSeparator(r rune) bool {
+	switch r {
+	case '、', '，', ',', '；', ';', '/', '|', ' ', '\t', '\n', '\f', '\r':
+		return true
+	}
+	return false
+}
@@ -0,0 +1,271 @@
+package pinyin
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	// currencies 货币符号的读法, 人民币按 元, 角, 分 读
+	currencies = map[string]string{
+		"¥": "元", "￥": "元", "$": "美元", "＄": "美元", "HK$": "港元", "€": "欧元", "£": "英镑",
+	}
+
+	// normalizers 按顺序尝试的改写, 每个返回改写的内容和结束位置
+	normalizers = []func(s string, i int, keep bool) (string, int, bool){normalizeDate, normalizeTime, normalizeMoney, normalizeRange}
+)
+
+// SetNormalize 是否改写日期, 时间, 金额和数量范围, 默认不改写
+// 改写后按 SetNumberStyle 的读法转换, NumberKeep 时保留阿拉伯数字, 只改写格式和货币符号
+// 不能与转换同时调用
+func (p *Dict) SetNormalize(normalize bool) {
+	p.normalize = normalize
+}
+
+// normalizeAt 将从 i 开始的日期, 时间, 金额或数量范围改写为汉字, 返回改写的内容和结束位置, 后面是字母或数字时不改写
+// 2024-10-19 → 二零二四年十月十九日, 3:45 → 三点四十五分, ¥12.50 → 十二元五角, 3-5个 → 三到五个
+// keep 为 true 时保留阿拉伯数字, 只改写格式和货币符号, 去掉月, 日, 分和秒的前导零, 如 2024-10-09 → 2024年10月9日, 3:05 → 3点5分, ¥80 → 80元
+func normalizeAt(s string, i int, keep bool) (string, int, bool) {
+	for _, normalize := range normalizers {
+		if repl, end, ok := normalize(s, i, keep); ok && (end == len(s) || !isWordByte(s[end])) {
+			return repl, end, true
+		}
+	}
+	return "", 0, false
+}
+
+// normalizeDate 日期, 如 2024-10-19, 2024/10/19, 2024.10.19
+func normalizeDate(s string, i int, keep bool) (string, int, bool) {
+	y := digitEnd(s, i)
+	if y-i != 4 || y == len(s) || strings.IndexByte("-/.", s[y]) < 0 {
+		return "", 0, false
+	}
+	m := digitEnd(s, y+1)
+	if m-y-1 < 1 || m-y-1 > 2 || m == len(s) || strings.IndexByte("-/.", s[m]) < 0 {
+		return "", 0, false
+	}
+	d := digitEnd(s, m+1)
+	if d-m-1 < 1 || d-m-1 > 2 {
+		return "", 0, false
+	}
+	year, monthDigits, dayDigits := s[i:y], s[y+1:m], s[m+1:d]
+	month, _ := strconv.Atoi(monthDigits)
+	day, _ := strconv.Atoi(dayDigits)
+	if month < 1 || month > 12 || day < 1 || day > 31 {
+		return "", 0, false
+	}
+	if keep {
+		return year + "年" + strconv.Itoa(month) + "月" + strconv.Itoa(day) + "日", d, true
+	}
+	return readDigits(year, false) + "年" + readCardinal(monthDigits) + "月" + readCardinal(dayDigits) + "日", d, true
+}
+
+// normalizeTime 时间, 如 3:45, 03:45:30
+func normalizeTime(s string, i int, keep bool) (string, int, bool) {
+	h := digitEnd(s, i)
+	n := colonLen(s, h)
+	if h-i < 1 || h-i > 2 || n == 0 {
+		return "", 0, false
+	}
+	// 分和秒为两位数字, 后面还有数字时由 normalizeAt 排除
+	m := h + n
+	if digitEnd(s, m)-m < 2 {
+		return "", 0, false
+	}
+	end := m + 2
+	var secondDigits string
+	if c := colonLen(s, end); c > 0 && digitEnd(s, end+c)-end-c >= 2 {
+		secondDigits = s[end+c : end+c+2]
+		end += c + 2
+	}
+	hourDigits, minuteDigits := s[i:h], s[m:m+2]
+
+	hour, _ := strconv.Atoi(hourDigits)
+	minute, _ := strconv.Atoi(minuteDigits)
+	second, _ := strconv.Atoi(secondDigits)
+	if hour > 24 || minute > 59 || second > 59 {
+		return "", 0, false
+	}
+	if keep {
+		time := strconv.Itoa(hour) + "点"
+		if minute > 0 || secondDigits != "" {
+			time += strconv.Itoa(minute) + "分"
+		}
+		if secondDigits != "" {
+			time += strconv.Itoa(second) + "秒"
+		}
+		return time, end, true
+	}
+	// 2:00 读作 两点
+	time := readCardinal(hourDigits) + "点"
+	if hour == 2 {
+		time = "两点"
+	}
+	if minute > 0 || secondDigits != "" {
+		time += readClock(minuteDigits) + "分"
+	}
+	if secondDigits != "" {
+		time += readClock(secondDigits) + "秒"
+	}
+	return time, end, true
+}
+
+// normalizeMoney 金额, 如 ¥12.50, $5
+func normalizeMoney(s string, i int, keep bool) (string, int, bool) {
+	symbol, unit := currencyAt(s[i:])
+	if symbol == "" {
+		return "", 0, false
+	}
+	start := i + len(symbol)
+	end := digitEnd(s, start)
+	if end == start {
+		return "", 0, false
+	}
+	integer := s[start:end]
+	// 小数最多两位, 后面还有数字时由 normalizeAt 排除
+	var fraction string
+	if end < len(s) && s[end] == '.' {
+		if n := digitEnd(s, end+1) - end - 1; n > 0 {
+			if n > 2 {
+				n = 2
+			}
+			fraction = s[end+1 : end+1+n]
+			end += 1 + n
+		}
+	}
+
+	if keep {
+		if fraction != "" {
+			return integer + "." + fraction + unit, end, true
+		}
+		return integer + unit, end, true
+	}
+	if unit != "元" {
+		number := readCardinal(integer)
+		if fraction := strings.TrimRight(fraction, "0"); fraction != "" {
+			number += "点" + readDigits(fraction, false)
+		}
+		return number + unit, end, true
+	}
+	return readYuan(integer, fraction), end, true
+}
+
+// normalizeRange 数量范围, 后面是量词或百分号, 如 3-5个, 10~20%
+func normalizeRange(s string, i int, keep bool) (string, int, bool) {
+	low := decimalEnd(s, i)
+	if low == i || low == len(s) {
+		return "", 0, false
+	}
+	n := 0
+	switch {
+	case s[low] == '-', s[low] == '~':
+		n = 1
+	case strings.HasPrefix(s[low:], "～"):
+		n = len("～")
+	default:
+		return "", 0, false
+	}
+	high := decimalEnd(s, low+n)
+	if high == low+n {
+		return "", 0, false
+	}
+	r, size := utf8.DecodeRuneInString(s[high:])
+	percent := r == '%' || r == '％'
+	if !percent && !unicode.Is(unicode.Han, r) {
+		return "", 0, false
+	}
+	from, to, unit := s[i:low], s[low+n:high], s[high:high+size]
+
+	if keep {
+		return from + "到" + to + unit, high + size, true
+	}
+	if percent {
+		return "百分之" + readDecimal(from) + "到百分之" + readDecimal(to), high + size, true
+	}
+	return readDecimal(from) + "到" + readDecimal(to) + unit, high + size, true
+}
+
+// currencyAt s 开头的货币符号及其读法, 没有时返回空字符串
+func currencyAt(s string) (string, string) {
+	// 货币符号为 $, HK$ 或非 ASCII 字符
+	if c := s[0]; c != '$' && c != 'H' && c < utf8.RuneSelf {
+		return "", ""
+	}
+	for symbol, unit := range currencies {
+		if strings.HasPrefix(s, symbol) {
+			return symbol, unit
+		}
+	}
+	return "", ""
+}
+
+// digitEnd 从 i 开始的连续数字的结束位置
+func digitEnd(s string, i int) int {
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	return i
+}
+
+// decimalEnd 从 i 开始的整数或小数的结束位置, 如 2.5
+func decimalEnd(s string, i int) int {
+	end := digitEnd(s, i)
+	if end > i && end < len(s) && s[end] == '.' {
+		if fraction := digitEnd(s, end+1); fraction > end+1 {
+			return fraction
+		}
+	}
+	return end
+}
+
+// colonLen i 处的冒号 (: 或 ：) 的字节数, 不是冒号时为 0
+func colonLen(s string, i int) int {
+	switch {
+	case strings.HasPrefix(s[i:], ":"):
+		return 1
+	case strings.HasPrefix(s[i:], "："):
+		return len("：")
+	}
+	return 0
+}
+
+// readClock 读分钟和秒, 如 05 → 零五, 45 → 四十五
+func readClock(digits string) string {
+	if len(digits) == 2 && digits[0] == '0' && digits[1] != '0' {
+		return chineseDigits[0] + readCardinal(digits)
+	}
+	return readCardinal(digits)
+}
+
+// readDecimal 按数值读小数, 如 2.5 → 二点五
+func readDecimal(number string) string {
+	i := strings.IndexByte(number, '.')
+	if i < 0 {
+		return readCardinal(number)
+	}
+	return readCardinal(number[:i]) + "点" + readDigits(number[i+1:], false)
+}
+
+// readYuan 按 元, 角, 分 读人民币金额, 如 12.05 → 十二元零五分
+func readYuan(integer, fraction string) string {
+	fraction += strings.Repeat("0", 2-len(fraction))
+	jiao, fen := fraction[0]-'0', fraction[1]-'0'
+
+	var builder strings.Builder
+	yuan := strings.TrimLeft(integer, "0") != ""
+	if yuan || (jiao == 0 && fen == 0) {
+		builder.WriteString(readCardinal(integer) + "元")
+	}
+	if jiao > 0 {
+		builder.WriteString(chineseDigits[jiao] + "角")
+	}
+	if fen > 0 {
+		if jiao == 0 && yuan {
+			builder.WriteString(chineseDigits[0])
+		}
+		builder.WriteString(chineseDigits[fen] + "分")
+	}
+	return builder.String()
+}
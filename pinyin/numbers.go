@@ -0,0 +1,257 @@
+package pinyin
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// NumberStyle 阿拉伯数字的读法
+type NumberStyle int
+
+const (
+	// NumberKeep 保留阿拉伯数字, 默认
+	NumberKeep NumberStyle = iota
+	// NumberAuto 年份和电话号码按位读, 其余按数值读, 如 2024年 → 二零二四年, 10年 → 十年, 123 → 一百二十三
+	NumberAuto
+	// NumberCardinal 按数值读, 如 123 → 一百二十三
+	NumberCardinal
+	// NumberDigits 按位读, 如 123 → 一二三
+	NumberDigits
+
+	// NumberYao 按位读时 1 读作 幺 (yāo), 可与 NumberAuto, NumberDigits 组合, 如 NumberAuto | NumberYao
+	// NumberAuto 只对电话号码生效
+	NumberYao NumberStyle = 1 << 4
+)
+
+var (
+	// chineseDigits 数字 0-9 的汉字
+	chineseDigits = []string{"零", "一", "二", "三", "四", "五", "六", "七", "八", "九"}
+	// chinesePositions 四位数中的数位
+	chinesePositions = []string{"千", "百", "十", ""}
+)
+
+// SetNumberStyle 设置阿拉伯数字的读法, 转换前将数字改写为汉字
+// 不能与转换同时调用
+func (p *Dict) SetNumberStyle(style NumberStyle) {
+	p.numbers = style
+}
+
+// verbalizeNumbers 按读法将阿拉伯数字改写为汉字, 设置 SetNormalize 时日期, 时间, 金额和数量范围先由 normalizeAt 改写
+// NumberKeep 时保留阿拉伯数字, 与字母相连的数字 (如 mp3, A4) 保持不变
+func (p *Dict) verbalizeNumbers(s string) string {
+	if strings.IndexAny(s, "0123456789") < 0 {
+		return s
+	}
+	style := p.numbers &^ NumberYao
+	if style == NumberKeep && !p.normalize {
+		return s
+	}
+	yao := p.numbers&NumberYao != 0
+
+	var builder strings.Builder
+	builder.Grow(len(s))
+	for i := 0; i < len(s); {
+		// 前面是字母或数字时不改写, 如 mp3, A4
+		if i == 0 || !isWordByte(s[i-1]) {
+			if p.normalize {
+				if repl, end, ok := normalizeAt(s, i, style == NumberKeep); ok {
+					builder.WriteString(repl)
+					i = end
+					continue
+				}
+			}
+			if style != NumberKeep {
+				if number, end, ok := readNumber(s, i, style, yao); ok {
+					builder.WriteString(number)
+					i = end
+					continue
+				}
+			}
+		}
+		_, size := utf8.DecodeRuneInString(s[i:])
+		builder.WriteString(s[i : i+size])
+		i += size
+	}
+	return builder.String()
+}
+
+// readNumber 按读法改写从 i 开始的数字, 可带负号, 小数和百分号, 返回改写的内容和结束位置
+// 后面是字母或数字时不改写
+func readNumber(s string, i int, style NumberStyle, yao bool) (string, int, bool) {
+	start := i
+	sign := false
+	switch {
+	case strings.HasPrefix(s[i:], "-"):
+		sign, start = true, i+1
+	case strings.HasPrefix(s[i:], "－"):
+		sign, start = true, i+len("－")
+	}
+	end := digitEnd(s, start)
+	if end == start {
+		return "", 0, false
+	}
+	integer := s[start:end]
+	var fraction string
+	if e := decimalEnd(s, start); e > end {
+		fraction = s[end+1 : e]
+		end = e
+	}
+	percent := false
+	switch {
+	case strings.HasPrefix(s[end:], "%"):
+		percent, end = true, end+1
+	case strings.HasPrefix(s[end:], "％"):
+		percent, end = true, end+len("％")
+	}
+	if end < len(s) && isWordByte(s[end]) {
+		return "", 0, false
+	}
+
+	var number string
+	switch {
+	case style == NumberDigits:
+		number = readDigits(integer, yao)
+	case style == NumberAuto && fraction == "" && !percent && !sign && isYear(integer, s[end:]):
+		number = readDigits(integer, false)
+	case style == NumberAuto && fraction == "" && !percent && !sign && isPhoneNumber(integer, s[:start]):
+		number = readDigits(integer, yao)
+	default:
+		number = readCardinal(integer)
+	}
+	if fraction != "" {
+		number += "点" + readDigits(fraction, style == NumberDigits && yao)
+	}
+	if percent {
+		number = "百分之" + number
+	}
+	if sign {
+		number = "负" + number
+	}
+	return number, end, true
+}
+
+// isWordByte 是否为字母, 数字或下划线
+func isWordByte(c byte) bool {
+	return c == '_' || isAlphaNumeric(rune(c))
+}
+
+// isYear 是否为年份, 四位数如 2024年, 两位数后面有月份时如 98年3月
+// 其他两位数是年数, 如 过了10年
+func isYear(integer, rest string) bool {
+	if !strings.HasPrefix(rest, "年") {
+		return false
+	}
+	switch len(integer) {
+	case 4:
+		return true
+	case 2:
+		month := strings.TrimPrefix(rest, "年")
+		if n := len(month) - len(strings.TrimLeft(month, "0123456789")); n == 1 || n == 2 {
+			return strings.HasPrefix(month[n:], "月")
+		}
+		if trimmed := strings.TrimLeft(month, "一二三四五六七八九十"); trimmed != month {
+			return strings.HasPrefix(trimmed, "月")
+		}
+	}
+	return false
+}
+
+// isPhoneNumber 是否为电话号码, 以 1 开头的 11 位手机号码, 以 0 开头的号码 (如区号), 或区号后的 7, 8 位号码
+// before 为数字前面的内容
+func isPhoneNumber(integer, before string) bool {
+	switch {
+	case len(integer) > 1 && integer[0] == '0':
+		return true
+	case len(integer) == 11 && integer[0] == '1':
+		return true
+	case len(integer) == 7 || len(integer) == 8:
+		return hasAreaCode(before)
+	}
+	return false
+}
+
+// hasAreaCode 是否以区号和连接号结尾, 如 010-, 0755-
+func hasAreaCode(s string) bool {
+	if !strings.HasSuffix(s, "-") {
+		return false
+	}
+	s = s[:len(s)-1]
+	n := len(s) - len(strings.TrimRight(s, "0123456789"))
+	return (n == 3 || n == 4) && s[len(s)-n] == '0'
+}
+
+// readDigits 按位读数字, 如 2024 → 二零二四
+func readDigits(digits string, yao bool) string {
+	var builder strings.Builder
+	for _, d := range digits {
+		if d == '1' && yao {
+			builder.WriteString("幺")
+			continue
+		}
+		builder.WriteString(chineseDigits[d-'0'])
+	}
+	return builder.String()
+}
+
+// readCardinal 按数值读整数, 如 10086 → 一万零八十六, 1234500000000 → 一万二千三百四十五亿
+// 超过 16 位时按位读
+func readCardinal(digits string) string {
+	digits = strings.TrimLeft(digits, "0")
+	if digits == "" {
+		return chineseDigits[0]
+	}
+	if len(digits) > 16 {
+		return readDigits(digits, false)
+	}
+
+	// 亿以上的部分按万以内的数读, 如 一万亿, 一千万亿
+	var number string
+	if len(digits) > 8 {
+		high, low := digits[:len(digits)-8], digits[len(digits)-8:]
+		number = readWan(high) + "亿"
+		if rest := strings.TrimLeft(low, "0"); rest != "" {
+			if rest != low {
+				number += chineseDigits[0]
+			}
+			number += readWan(rest)
+		}
+	} else {
+		number = readWan(digits)
+	}
+
+	// 10-19 读作 十, 十一, 而不是 一十, 一十一
+	if strings.HasPrefix(number, "一十") {
+		number = strings.TrimPrefix(number, "一")
+	}
+	return number
+}
+
+// readWan 按数值读不超过 8 位的正整数, 如 10086 → 一万零八十六
+func readWan(digits string) string {
+	// 补齐为 8 位, 前 4 位为 万 的部分
+	digits = strings.Repeat("0", 8-len(digits)) + digits
+
+	var builder strings.Builder
+	zero := false
+	for g, unit := range []string{"万", ""} {
+		group := digits[g*4 : g*4+4]
+		if group == "0000" {
+			zero = builder.Len() > 0
+			continue
+		}
+		for i, d := range group {
+			if d == '0' {
+				zero = zero || builder.Len() > 0
+				continue
+			}
+			if zero {
+				builder.WriteString(chineseDigits[0])
+				zero = false
+			}
+			builder.WriteString(chineseDigits[d-'0'])
+			builder.WriteString(chinesePositions[i])
+		}
+		builder.WriteString(unit)
+	}
+	return builder.String()
+}
@@ -0,0 +1,143 @@
+package pinyin
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// VStyle ü 的写法
+type VStyle string
+
+const (
+	// VStyleV 写作 v, 如 lv3
+	VStyleV VStyle = "v"
+	// VStyleUmlaut 写作 ü, 如 lü3
+	VStyleUmlaut VStyle = "ü"
+	// VStyleColon 写作 u:, 如 lu:3
+	VStyleColon VStyle = "u:"
+	// VStyleYu 写作 yu, 如 lyu3 (护照拼写)
+	VStyleYu VStyle = "yu"
+	// VStyleU 写作 u, 如 lu3
+	VStyleU VStyle = "u"
+)
+
+// TonePosition 数字声调的位置
+type TonePosition int
+
+const (
+	// ToneFinal 数字声调在音节末尾, 如 zhuang1
+	ToneFinal TonePosition = iota
+	// ToneAfterVowel 数字声调在标调的元音后面, 如 zhua1ng
+	ToneAfterVowel
+)
+
+// Case 拼音的大小写
+type Case int
+
+const (
+	// CaseLower 小写, 如 zhong guo
+	CaseLower Case = iota
+	// CaseUpper 大写, 如 ZHONG GUO
+	CaseUpper
+	// CaseTitle 每个音节首字母大写, 如 Zhong Guo
+	CaseTitle
+	// CaseFirstWordTitle 第一个音节首字母大写, 如 Zhong guo
+	CaseFirstWordTitle
+)
+
+// superscripts 上标数字
+var superscripts = []string{"⁰", "¹", "²", "³", "⁴", "⁵", "⁶", "⁷", "⁸", "⁹"}
+
+// Option 输出选项
+type Option func(*options)
+
+// options 输出选项
+type options struct {
+	// v ü 的写法, 为空时 ASCII/None 保持 lv, lue 的写法, Unicode 写作 ü
+	v VStyle
+	// position 数字声调的位置
+	position TonePosition
+	// neutral 轻声的数字, 为 -1 时轻声不标数字
+	neutral int
+	// superscript 是否使用上标数字声调
+	superscript bool
+	// letterCase 拼音的大小写
+	letterCase Case
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{neutral: -1}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithVStyle 设置 ü 的写法, 只影响 lü, nü 等音节, ju, qu, xu, yu 保持不变
+func WithVStyle(style VStyle) Option {
+	return func(o *options) {
+		o.v = style
+	}
+}
+
+// WithTonePosition 设置数字声调的位置, 只影响 ASCII 输出
+func WithTonePosition(position TonePosition) Option {
+	return func(o *options) {
+		o.position = position
+	}
+}
+
+// WithNeutralTone 轻声使用数字标出, 通常为 5 或 0, 如 de5, 只影响 ASCII 输出
+func WithNeutralTone(digit int) Option {
+	return func(o *options) {
+		o.neutral = digit
+	}
+}
+
+// WithSuperscriptTone 使用上标数字声调, 如 zhuang¹, 只影响 ASCII 输出
+func WithSuperscriptTone() Option {
+	return func(o *options) {
+		o.superscript = true
+	}
+}
+
+// toneDigit 返回音节的数字声调, 没有声调时返回空字符串
+func (o *options) toneDigit(s Syllable) string {
+	tone := s.Tone
+	if tone == 0 {
+		// 儿化音 r 不标声调
+		if o.neutral < 0 || o.neutral > 9 || s.Text == "r" {
+			return ""
+		}
+		tone = o.neutral
+	}
+	if o.superscript {
+		return superscripts[tone]
+	}
+	return string(rune('0' + tone))
+}
+
+// WithCase 设置拼音的大小写, 输入中原有的非中文内容保持不变
+func WithCase(c Case) Option {
+	return func(o *options) {
+		o.letterCase = c
+	}
+}
+
+// applyCase 转换音节的大小写, first 表示是否为第一个音节
+func (o *options) applyCase(s string, first bool) string {
+	switch {
+	case o.letterCase == CaseUpper:
+		return strings.ToUpper(s)
+	case o.letterCase == CaseTitle, o.letterCase == CaseFirstWordTitle && first:
+		return title(s)
+	}
+	return s
+}
+
+// title 首字母大写
+func title(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	return string(unicode.ToUpper(r)) + s[size:]
+}
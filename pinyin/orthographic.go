@@ -0,0 +1,144 @@
+package pinyin
+
+import (
+	_ "embed"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// properNounData 内置的专有名词, 以空白分隔, # 开头的行为注释
+//
+//go:embed data/propernouns.txt
+var properNounData string
+
+var (
+	properNounsOnce sync.Once
+	// properNounSet 内置的专有名词, 第一次使用时由 properNounData 生成
+	properNounSet map[string]bool
+	// maxProperNounLen 最长的内置专有名词的字数
+	maxProperNounLen int
+
+	// sentenceEnds 句末标点
+	sentenceEnds = "。！？.!?"
+)
+
+// loadProperNouns 由 properNounData 生成 properNounSet
+func loadProperNouns() {
+	properNounSet = map[string]bool{}
+	for _, line := range strings.Split(properNounData, "\n") {
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		for _, noun := range strings.Fields(line) {
+			properNounSet[noun] = true
+			if n := utf8.RuneCountInString(noun); n > maxProperNounLen {
+				maxProperNounLen = n
+			}
+		}
+	}
+}
+
+// AddProperNouns 添加专有名词, 正词法中连写且首字母大写, 如 长沙 → chang2 sha1
+// 读音为带数字声调的音节, 以空格分隔, 为空时使用词典中的读音, 不能与转换同时调用
+func (p *Dict) AddProperNouns(words map[string]string) {
+	if p.properNouns == nil {
+		p.properNouns = newTable(nil)
+	}
+	for word, reading := range words {
+		p.properNouns.add(word, reading)
+	}
+}
+
+// Orthographic 按拼音正词法 (GB/T 16159) 转换, 保留标点符号
+// 词内的音节连写, a, o, e 开头的音节前加隔音符号, 句首和专有名词首字母大写
+// 西安是一个城市。 → Xī'ān shì yígè chéngshì.
+func (p *Dict) Orthographic(s string) (result *ConvertResult) {
+	input := s
+	words := p.segmentWords(p.prepare(s))
+
+	m := &marked{}
+	sentenceStart := true
+	for _, w := range words {
+		if w.syllables == nil {
+			m.writeTidy(w.text)
+			for _, r := range w.text {
+				if strings.ContainsRune(sentenceEnds, r) {
+					sentenceStart = true
+				} else if unicode.IsLetter(r) || unicode.IsDigit(r) {
+					sentenceStart = false
+				}
+			}
+			continue
+		}
+		m.separate()
+		capital := sentenceStart || p.isProperNoun(w.text)
+		for i, syllable := range w.syllables {
+			m.writeSyllable(syllable, i == 0 && capital)
+			// 隔音符号
+			if i > 0 && needsApostrophe(syllable) {
+				m.spans[len(m.spans)-1].apostrophe = true
+			}
+		}
+		sentenceStart = false
+	}
+
+	result = punctuate(m).result(input, words)
+	return
+}
+
+// isProperNoun 是否为专有名词
+func (p *Dict) isProperNoun(s string) bool {
+	if _, ok := p.properNouns.lookup(s); ok {
+		return true
+	}
+	properNounsOnce.Do(loadProperNouns)
+	return properNounSet[s]
+}
+
+// segmentWords 按专有名词和词典分词, 专有名词优先
+func (p *Dict) segmentWords(s string) []word {
+	properNounsOnce.Do(loadProperNouns)
+	maxLen := maxProperNounLen
+	if p.properNouns != nil && p.properNouns.maxLen > maxLen {
+		maxLen = p.properNouns.maxLen
+	}
+	runes := []rune(s)
+
+	var words []word
+	last := 0
+	for i := 0; i < len(runes); {
+		n := maxLen
+		if i+n > len(runes) {
+			n = len(runes) - i
+		}
+		for ; n > 0; n-- {
+			if p.isProperNoun(string(runes[i : i+n])) {
+				break
+			}
+		}
+		if n == 0 {
+			i++
+			continue
+		}
+		if last < i {
+			words = append(words, p.segment(string(runes[last:i]))...)
+		}
+		noun := word{text: string(runes[i : i+n])}
+		if reading, _ := p.properNouns.lookup(noun.text); reading != "" {
+			noun.syllables = strings.Fields(reading)
+		} else {
+			for _, w := range p.segment(noun.text) {
+				noun.syllables = append(noun.syllables, w.syllables...)
+			}
+		}
+		words = append(words, noun)
+		i += n
+		last = i
+	}
+	if last < len(runes) {
+		words = append(words, p.segment(string(runes[last:]))...)
+	}
+	return words
+}
@@ -0,0 +1,192 @@
+package pinyin
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// PassportStyle 护照姓名的拼写规则
+type PassportStyle int
+
+const (
+	// PassportMainland 中国大陆 (GB/T 28039-2011), ü 写作 YU, 如 LYU XIAOMING
+	PassportMainland PassportStyle = iota
+	// PassportMainlandU 中国大陆, ü 写作 U, 如 LU XIAOMING
+	PassportMainlandU
+	// PassportHongKong 香港, 使用 AddCantonese 添加的粤语拼音, 如 CHAN TAI MAN
+	PassportHongKong
+	// PassportTaiwan 台湾, 威妥玛拼音, 不带送气符号和分音符, 如 WANG HSIAO-MING
+	PassportTaiwan
+)
+
+var (
+	// wadeGilesInitials 声母的威妥玛拼音
+	wadeGilesInitials = map[string]string{
+		"b": "p", "p": "p'", "d": "t", "t": "t'", "g": "k", "k": "k'",
+		"j": "ch", "q": "ch'", "x": "hs", "zh": "ch", "ch": "ch'", "r": "j",
+		"z": "ts", "c": "ts'",
+	}
+	// wadeGilesSyllables 整体转换的音节
+	wadeGilesSyllables = map[string]string{
+		"zi": "tzu", "ci": "tz'u", "si": "ssu",
+		"zhi": "chih", "chi": "ch'ih", "shi": "shih", "ri": "jih",
+		"e": "o", "er": "erh", "r": "erh",
+		"yi": "i", "you": "yu", "yan": "yen", "ye": "yeh", "yong": "yung",
+		"yu": "yü", "yue": "yüeh", "yuan": "yüan", "yun": "yün",
+	}
+)
+
+// ErrNoCantonese 缺少粤语拼音
+var ErrNoCantonese = errors.New("pinyin: no cantonese reading")
+
+// AddCantonese 添加粤语拼音, 用于香港护照拼写, 如 陈 → chan, 大文 → tai man
+// 不能与转换同时调用
+func (p *Dict) AddCantonese(words map[string]string) {
+	if p.cantonese == nil {
+		p.cantonese = newTable(nil)
+	}
+	for word, reading := range words {
+		p.cantonese.add(word, reading)
+	}
+}
+
+// PassportName 按护照格式转换人名
+// 姓在前并大写, 名字连写, 不带声调, 如 ZHANG SAN, OUYANG XIU
+func (p *Dict) PassportName(name string, style PassportStyle) (string, error) {
+	surname, givenName := p.splitName(strings.TrimSpace(name))
+	if surname == "" {
+		return "", fmt.Errorf("pinyin: %q is not a chinese name", name)
+	}
+
+	if style == PassportHongKong {
+		surnameReading, err := p.cantoneseReading(surname)
+		if err != nil {
+			return "", err
+		}
+		givenReading, err := p.cantoneseReading(givenName)
+		if err != nil {
+			return "", err
+		}
+		return strings.ToUpper(strings.TrimSpace(surnameReading + " " + givenReading)), nil
+	}
+
+	surnameSyllables := p.surnameWord(surname).syllables
+	var givenSyllables []string
+	for _, w := range p.segmentGivenName(givenName) {
+		if w.syllables == nil {
+			return "", fmt.Errorf("pinyin: no reading for %q in %q", w.text, name)
+		}
+		givenSyllables = append(givenSyllables, w.syllables...)
+	}
+	if surnameSyllables == nil {
+		return "", fmt.Errorf("pinyin: no reading for %q in %q", surname, name)
+	}
+
+	var givenSep string
+	switch style {
+	case PassportTaiwan:
+		givenSep = "-"
+	}
+	result := passportSpelling(surnameSyllables, "", style)
+	if len(givenSyllables) > 0 {
+		result += " " + passportSpelling(givenSyllables, givenSep, style)
+	}
+	return result, nil
+}
+
+// passportSpelling 按护照规则拼写音节
+func passportSpelling(syllables []string, sep string, style PassportStyle) string {
+	split := make([]string, len(syllables))
+	for i, s := range syllables {
+		syllable, ok := tokenSyllable(s)
+		if !ok {
+			syllable = Syllable{Text: strings.TrimRight(s, "012345")}
+		}
+		switch style {
+		case PassportTaiwan:
+			split[i] = strings.NewReplacer("'", "", "ü", "u").Replace(wadeGiles(syllable.Text))
+		case PassportMainlandU:
+			split[i] = syllable.None(WithVStyle(VStyleU))
+		default:
+			split[i] = syllable.None(WithVStyle(VStyleYu))
+		}
+	}
+	return strings.ToUpper(strings.Join(split, sep))
+}
+
+// cantoneseReading 按最长匹配查找粤语拼音
+func (p *Dict) cantoneseReading(s string) (string, error) {
+	if p.cantonese == nil {
+		return "", fmt.Errorf("%w: %q", ErrNoCantonese, s)
+	}
+	runes := []rune(s)
+	var split []string
+	for i := 0; i < len(runes); {
+		n := p.cantonese.maxLen
+		if i+n > len(runes) {
+			n = len(runes) - i
+		}
+		for ; n > 0; n-- {
+			if reading, ok := p.cantonese.lookup(string(runes[i : i+n])); ok {
+				split = append(split, reading)
+				break
+			}
+		}
+		if n == 0 {
+			return "", fmt.Errorf("%w: %q", ErrNoCantonese, string(runes[i]))
+		}
+		i += n
+	}
+	return strings.Join(split, " "), nil
+}
+
+// wadeGiles 将不带声调的拼音音节转换为威妥玛拼音
+// zhang → chang, xiao → hsiao, qiu → ch'iu
+func wadeGiles(s string) string {
+	if wg, ok := wadeGilesSyllables[s]; ok {
+		return wg
+	}
+
+	initial := ""
+	for _, i := range []string{"zh", "ch", "sh", "b", "p", "m", "f", "d", "t", "n", "l", "g", "k", "h", "j", "q", "x", "r", "z", "c", "s", "y", "w"} {
+		if strings.HasPrefix(s, i) && len(s) > len(i) {
+			initial = i
+			break
+		}
+	}
+	final := s[len(initial):]
+
+	switch {
+	case (initial == "j" || initial == "q" || initial == "x") && final[0] == 'u':
+		// j, q, x 后的 u 为 ü
+		final = "ü" + final[1:]
+	case (initial == "l" || initial == "n") && final == "ue":
+		final = "üe"
+	case final == "v":
+		final = "ü"
+	}
+
+	switch {
+	case final == "ie", final == "üe":
+		final += "h"
+	case strings.HasSuffix(final, "ian"):
+		final = strings.TrimSuffix(final, "ian") + "ien"
+	case strings.HasSuffix(final, "ong"):
+		final = strings.TrimSuffix(final, "ong") + "ung"
+	case final == "e" && (initial == "g" || initial == "k" || initial == "h"):
+		final = "o"
+	case final == "ui" && (initial == "g" || initial == "k"):
+		final = "uei"
+	case final == "uo":
+		switch initial {
+		case "d", "t", "n", "l", "z", "c", "s", "zh", "ch", "r":
+			final = "o"
+		}
+	}
+
+	if wg, ok := wadeGilesInitials[initial]; ok {
+		initial = wg
+	}
+	return initial + final
+}
@@ -1,12 +1,13 @@
 package pinyin
 
 import (
-	"regexp"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
 var (
-	// punctuations 标点符号
+	// punctuations 默认的标点符号映射, 空格由 punctuate 按标点的位置添加
 	punctuations = []string{
 		// 逗号
 		"，", ",",
@@ -21,17 +22,17 @@ var (
 		// 分号
 		"；", ";",
 		// 左/右单引号
-		"‘", " '", "’", " '",
+		"‘", "'", "’", "'",
 		// 左/右双引号
-		"“", ` "`, "”", ` "`,
+		"“", `"`, "”", `"`,
 		// 左/右直角引号
-		"「", " [", "」", " ]",
-		"『", " [", "』", " ]",
+		"「", "[", "」", "]",
+		"『", "[", "』", "]",
 		// 左/右括号
-		"（", " (", "）", " )",
-		"〔", " [", "〕", " ]",
-		"【", " [", "】", " ]",
-		"{", " {", "}", " }",
+		"（", "(", "）", ")",
+		"〔", "[", "〕", "]",
+		"【", "[", "】", "]",
+		"{", "{", "}", "}",
 		// 省略号
 		"……", "...",
 		// 破折号
@@ -39,112 +40,291 @@ var (
 		// 连接号
 		"—", "-",
 		// 左/右斜杆
-		"/", " /", "\\", " \\",
+		"/", "/", "\\", "\\",
 		// 波浪线
 		"～", "~",
 		// 书名号
-		"《", " <", "》", " >",
-		"〈", " <", "〉", " >",
+		"《", "<", "》", ">",
+		"〈", "<", "〉", ">",
 		// 间隔号
-		"·", " ·",
+		"·", "·",
 		// 顿号
 		"、", ",",
-	}
-	// finals 韵母表
-	finals = []string{
-		// a
-		"a1", "ā", "a2", "á", "a3", "ǎ", "a4", "à",
-		// o
-		"o1", "ō", "o2", "ó", "o3", "ǒ", "o4", "ò",
-		// e
-		"e1", "ē", "e2", "é", "e3", "ě", "e4", "è",
-		// i
-		"i1", "ī", "i2", "í", "i3", "ǐ", "i4", "ì",
-		// u
-		"u1", "ū", "u2", "ú", "u3", "ǔ", "u4", "ù",
-		// v
-		"v1", "ǖ", "v2", "ǘ", "v3", "ǚ", "v4", "ǜ",
-
-		// ai
-		"ai1", "āi", "ai2", "ái", "ai3", "ǎi", "ai4", "ài",
-		// ei
-		"ei1", "ēi", "ei2", "éi", "ei3", "ěi", "ei4", "èi",
-		// ui
-		"ui1", "uī", "ui2", "uí", "ui3", "uǐ", "ui4", "uì",
-		// ao
-		"ao1", "āo", "ao2", "áo", "ao3", "ǎo", "ao4", "ào",
-		// ou
-		"ou1", "ōu", "ou2", "óu", "ou3", "ǒu", "ou4", "òu",
-		// iu
-		"iu1", "īu", "iu2", "íu", "iu3", "ǐu", "iu4", "ìu",
-
-		// ie
-		"ie1", "iē", "ie2", "ié", "ie3", "iě", "ie4", "iè",
-		// ve
-		"ue1", "üē", "ue2", "üé", "ue3", "üě", "ue4", "üè",
-		// er
-		"er1", "ēr", "er2", "ér", "er3", "ěr", "er4", "èr",
-
-		// an
-		"an1", "ān", "an2", "án", "an3", "ǎn", "an4", "àn",
-		// en
-		"en1", "ēn", "en2", "én", "en3", "ěn", "en4", "èn",
-		// in
-		"in1", "īn", "in2", "ín", "in3", "ǐn", "in4", "ìn",
-		// un/vn
-		"un1", "ūn", "un2", "ún", "un3", "ǔn", "un4", "ùn",
-
-		// ang
-		"ang1", "āng", "ang2", "áng", "ang3", "ǎng", "ang4", "àng",
-		// eng
-		"eng1", "ēng", "eng2", "éng", "eng3", "ěng", "eng4", "èng",
-		// ing
-		"ing1", "īng", "ing2", "íng", "ing3", "ǐng", "ing4", "ìng",
-		// ong
-		"ong1", "ōng", "ong2", "óng", "ong3", "ǒng", "ong4", "òng",
+		// 百分号
+		"％", "%",
 	}
 )
 
 // -----------------------------------------------------------------------------
 
-// ConvertResult 转换结果
-type ConvertResult string
+// ConvertResult 转换结果, 按声调和选项输出拼音
+// 保存拼音音节的位置, 转换前的文本和分词结果, 复制后输出相同
+type ConvertResult struct {
+	// s 拼音, 音节均为小写
+	s string
+	// spans 拼音音节在 s 中的位置, 其他内容按原样输出
+	spans []span
+	// inferred 音节的位置由字符串推断, 可能是原文中的英文单词, 不改变大小写
+	inferred bool
+	// input 转换前的文本, 由 JSON 输出
+	input string
+	// words 转换时的分词结果, 由 JSON 输出
+	words []word
+}
+
+// span 拼音音节的位置
+type span struct {
+	start, end int
+	// title 是否首字母大写 (句首和专有名词)
+	title bool
+	// apostrophe 前面是否需要隔音符号, 前一个音节带数字声调时不加, 如 xi'an, xi1an1
+	apostrophe bool
+}
 
 // NewConvertResult 创建转换结果对象
+// 字符串中的合法音节 (如 mei3, hao) 按声调和 ü 的选项输出, 但不改变大小写, 因为无法区分拼音和英文单词 (如 he, a, man)
+// 需要大小写的选项时使用 NewConvertResultFromSyllables
 func NewConvertResult(s string) *ConvertResult {
-	cr := ConvertResult(s)
-	return &cr
+	r := &ConvertResult{s: s, inferred: true}
+	// 音节为连续的字母, 后面可以有一个声调数字, 如 mei3
+	for i := 0; i < len(s); {
+		start := i
+		for i < len(s) && isLetterByte(s[i]) {
+			i++
+		}
+		if i == start {
+			i++
+			continue
+		}
+		if i < len(s) && s[i] >= '1' && s[i] <= '4' {
+			i++
+		}
+		if _, ok := tokenSyllable(s[start:i]); ok {
+			r.spans = append(r.spans, span{start: start, end: i})
+		}
+	}
+	return r
 }
 
 // ASCII 带数字的声调
 // mei3 hao3
-func (r *ConvertResult) ASCII() string {
-	return string(*r)
+func (r *ConvertResult) ASCII(opts ...Option) string {
+	return r.format(toneNumber, newOptions(opts))
 }
 
 // Unicode Unicode声调
 // měi hǎo
-func (r *ConvertResult) Unicode() string {
-	s := string(*r)
-	for i := len(finals) - 1; i >= 0; i -= 2 {
-		s = strings.Replace(s, finals[i-1], finals[i], -1)
-	}
-	return s
+func (r *ConvertResult) Unicode(opts ...Option) string {
+	return r.format(toneMark, newOptions(opts))
 }
 
 // None 不带声调输出
 // mei hao
-func (r *ConvertResult) None() string {
-	s := string(*r)
-	re := regexp.MustCompile(`[1-4]{1}`)
-	s = re.ReplaceAllString(s, "")
-	return s
+func (r *ConvertResult) None(opts ...Option) string {
+	return r.format(toneNone, newOptions(opts))
+}
+
+// String 同不带选项的 ASCII
+func (r ConvertResult) String() string {
+	return r.ASCII()
+}
+
+// format 按声调风格和选项输出拼音音节, 其他内容保持不变
+func (r *ConvertResult) format(style toneStyle, o *options) string {
+	var builder strings.Builder
+	// 带声调符号的音节比数字声调多一个字节
+	builder.Grow(len(r.s) + len(r.spans))
+	last := 0
+	for i, sp := range r.spans {
+		builder.WriteString(r.s[last:sp.start])
+		if sp.apostrophe {
+			if r, _ := utf8.DecodeLastRuneInString(builder.String()); !unicode.IsNumber(r) {
+				builder.WriteByte('\'')
+			}
+		}
+		token := r.s[sp.start:sp.end]
+		last = sp.end
+		if syllable, ok := tokenSyllable(token); ok {
+			// 小写的音节直接写入, 不分配内存
+			if (o.letterCase == CaseLower || r.inferred) && !sp.title {
+				syllable.writeTo(&builder, style, o)
+				continue
+			}
+			token = syllable.format(style, o)
+		}
+		token = o.applyCase(token, i == 0)
+		if sp.title {
+			token = title(token)
+		}
+		builder.WriteString(token)
+	}
+	builder.WriteString(r.s[last:])
+	return builder.String()
+}
+
+// abbr 每个音节的小写首字母, 以 sep 分隔
+func (r *ConvertResult) abbr(sep string) string {
+	split := make([]string, len(r.spans))
+	for i, sp := range r.spans {
+		split[i] = strings.ToLower(r.s[sp.start : sp.start+1])
+	}
+	return strings.Join(split, sep)
 }
 
 // -----------------------------------------------------------------------------
 
+// marked 转换过程中的拼音, 音节的位置另外记录在 spans 中, 原文中的任何字符都不会被当作音节
+type marked struct {
+	buf   []byte
+	spans []span
+	// space 下一次写入内容前是否添加空格, 由 writeTidy 设置
+	space bool
+}
+
+// flush 写入 writeTidy 留下的空格
+func (m *marked) flush() {
+	if m.space {
+		m.buf = append(m.buf, ' ')
+		m.space = false
+	}
+}
+
+// writeSyllable 写入音节, title 表示首字母大写
+func (m *marked) writeSyllable(syllable string, title bool) {
+	m.copySyllable(syllable, span{title: title})
+}
+
+// copySyllable 写入音节, 使用 sp 的首字母大写和隔音符号
+func (m *marked) copySyllable(syllable string, sp span) {
+	m.flush()
+	sp.start = len(m.buf)
+	m.buf = append(m.buf, syllable...)
+	sp.end = len(m.buf)
+	m.spans = append(m.spans, sp)
+}
+
+// writeString 按原样写入音节以外的内容
+func (m *marked) writeString(s string) {
+	m.flush()
+	m.buf = append(m.buf, s...)
+}
+
+// writeTidy 写入音节以外的内容, 空格和分隔符合并为一个空格, 并去除两端的空格
+func (m *marked) writeTidy(s string) {
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c == '\t' || c == ' ' {
+			m.space = len(m.buf) > 0
+			continue
+		}
+		m.flush()
+		m.buf = append(m.buf, s[i])
+	}
+}
+
+// separate 在下一次写入的内容前添加空格, 开头不添加
+func (m *marked) separate() {
+	m.space = len(m.buf) > 0
+}
+
+// writeMarked 写入另一段拼音, 其中的空格替换为 sep
+func (m *marked) writeMarked(src *marked, sep string) {
+	m.flush()
+	k := 0
+	for i := 0; i < len(src.buf); {
+		if k < len(src.spans) && src.spans[k].start == i {
+			sp := src.spans[k]
+			m.copySyllable(string(src.buf[sp.start:sp.end]), sp)
+			i = sp.end
+			k++
+			continue
+		}
+		if src.buf[i] == ' ' {
+			m.buf = append(m.buf, sep...)
+		} else {
+			m.buf = append(m.buf, src.buf[i])
+		}
+		i++
+	}
+}
+
+// sliceItem ToSlice 切分出的一项, syllable 为音节在 spans 中的下标, 不是音节时为 -1
+type sliceItem struct {
+	start, end int
+	syllable   int
+}
+
+// slice 与 ToSlice 相同, 按字母和声调数字切分, 每个音节单独为一项
+func (m *marked) slice() []sliceItem {
+	var items []sliceItem
+	k := 0
+	for i := 0; i < len(m.buf); {
+		if k < len(m.spans) && m.spans[k].start == i {
+			items = append(items, sliceItem{start: i, end: m.spans[k].end, syllable: k})
+			i = m.spans[k].end
+			k++
+			continue
+		}
+		j := i
+		for j < len(m.buf) && isSliceByte(m.buf[j]) && !(k < len(m.spans) && m.spans[k].start == j) {
+			j++
+		}
+		if j > i {
+			items = append(items, sliceItem{start: i, end: j, syllable: -1})
+			i = j
+		} else {
+			i++
+		}
+	}
+	return items
+}
+
+// join 将 slice 切分出的各项以 sep 连接
+func (m *marked) join(sep string) *marked {
+	joined := &marked{}
+	for i, item := range m.slice() {
+		if i > 0 {
+			joined.writeString(sep)
+		}
+		m.writeItem(joined, item)
+	}
+	return joined
+}
+
+// writeItem 将 slice 切分出的一项写入 dst
+func (m *marked) writeItem(dst *marked, item sliceItem) {
+	text := string(m.buf[item.start:item.end])
+	if item.syllable < 0 {
+		dst.writeString(text)
+		return
+	}
+	dst.copySyllable(text, m.spans[item.syllable])
+}
+
+// result 创建转换结果对象, input 和 words 为转换前的文本和分词结果
+func (m *marked) result(input string, words []word) *ConvertResult {
+	return &ConvertResult{s: string(m.buf), spans: m.spans, input: input, words: words}
+}
+
 // Dict 拼音词典
-type Dict struct{}
+// 添加词语等设置完成后, 转换的方法可以在多个 goroutine 中同时调用
+type Dict struct {
+	// words 用户添加的词语读音
+	words *table
+	// surnames 用户添加的姓氏读音
+	surnames *table
+	// givenNames 用户添加的名字读音
+	givenNames *table
+	// cantonese 粤语拼音
+	cantonese *table
+	// properNouns 用户添加的专有名词
+	properNouns *table
+	// numbers 阿拉伯数字的读法
+	numbers NumberStyle
+	// normalize 改写日期, 时间, 金额和数量范围
+	normalize bool
+	// keepWidth 保留全角字符
+	keepWidth bool
+}
 
 // NewDict 新建拼音词典对象
 func NewDict() *Dict {
@@ -153,200 +333,300 @@ func NewDict() *Dict {
 
 // Convert 中文转换为拼音, 不保留标点符号
 func (p *Dict) Convert(s string, sep string) (result *ConvertResult) {
-	s = p.romanize(s, false)
-
-	split := ToSlice(s)
+	input := s
+	m, words := p.romanize(s, false)
 
-	result = NewConvertResult(strings.Join(split, sep))
+	result = m.join(sep).result(input, words)
 	return
 }
 
-// Sentence 中文转换为拼音, 保留标点符号
-func (p *Dict) Sentence(s string) (result *ConvertResult) {
-	s = p.romanize(s, false)
+// ConvertJoined 中文转换为连写的拼音, 不保留标点符号
+// 音节之间没有分隔符, a, o, e 开头的音节前加隔音符号, 如 xi'an 与 xian 可以区分, 带数字声调时不加, 如 xi1an1
+// 拼音与其他内容之间以空格分隔, 如 iPhone是好的 → iPhone shihaode
+func (p *Dict) ConvertJoined(s string) (result *ConvertResult) {
+	input := s
+	m, words := p.romanize(s, false)
+
+	joined := &marked{}
+	prev := -1
+	for i, item := range m.slice() {
+		if i > 0 && (item.syllable < 0 || prev < 0) {
+			joined.writeString(" ")
+		}
+		m.writeItem(joined, item)
+		if i > 0 && item.syllable >= 0 && prev >= 0 && needsApostrophe(string(m.buf[item.start:item.end])) {
+			joined.spans[len(joined.spans)-1].apostrophe = true
+		}
+		prev = item.syllable
+	}
 
-	r := regexp.QuoteMeta(strings.Join(punctuations, ""))
-	r = strings.Replace(r, " ", "", -1)
-	re := regexp.MustCompile("[^a-zA-Z0-9" + r + `\s_]+`)
-	s = re.ReplaceAllString(s, "")
+	result = joined.result(input, words)
+	return
+}
 
-	for i := 0; i < len(punctuations); i += 2 {
-		s = strings.Replace(s, punctuations[i], punctuations[i+1], -1)
+// Sentence 中文转换为拼音, 保留标点符号
+// 可以传入 PunctuationMap 指定标点符号的转换方式, 默认为 DefaultPunctuationMap()
+func (p *Dict) Sentence(s string, punctuation ...PunctuationMap) (result *ConvertResult) {
+	input := s
+	m, words := p.romanize(s, false)
+	if len(punctuation) > 0 {
+		m = newPunctuator(punctuation[0]).punctuate(m)
+	} else {
+		m = punctuate(m)
 	}
 
-	result = NewConvertResult(s)
+	result = m.result(input, words)
 	return
 }
 
-// Name 转换人名
+// Name 转换一个人名, 开头的姓氏使用姓氏读音, 人名列表使用 Names
 func (p *Dict) Name(s string, sep string) (result *ConvertResult) {
-	s = p.romanize(s, true)
+	input := s
+	m, words := p.romanize(s, true)
 
-	split := ToSlice(s)
-
-	result = NewConvertResult(strings.Join(split, sep))
+	result = m.join(sep).result(input, words)
 	return
 }
 
 // Abbr 获取拼音的首字符
 func (p *Dict) Abbr(s string, sep string) string {
-	s = p.romanize(s, false)
+	m, _ := p.romanize(s, false)
 
 	var abbr []string
-	for _, item := range ToSlice(s) {
-		abbr = append(abbr, item[0:1])
+	for _, item := range m.slice() {
+		abbr = append(abbr, string(m.buf[item.start]))
 	}
 
 	return strings.Join(abbr, sep)
 }
 
 func (p *Dict) prepare(s string) string {
-	var re *regexp.Regexp
-
-	re = regexp.MustCompile(`[a-zA-Z0-9_-]+`)
-	s = re.ReplaceAllStringFunc(s, func(repl string) string {
-		return "\t" + repl
-	})
-
-	re = regexp.MustCompile(`[^\p{Han}\p{P}\p{Z}\p{M}\p{N}\p{L}\t]`)
-	s = re.ReplaceAllString(s, "")
+	if !p.keepWidth {
+		s = foldWidth(s)
+	}
+	s = p.verbalizeNumbers(s)
+
+	// 字母和数字前插入分隔符, 去除汉字, 标点, 空白, 字母和数字以外的字符
+	buf := getBuffer()
+	defer putBuffer(buf)
+	word := false
+	for _, r := range s {
+		switch {
+		case isAlphaNumeric(r) || r == '_' || r == '-':
+			if !word {
+				buf.WriteByte('\t')
+				word = true
+			}
+			buf.WriteRune(r)
+		case r == '\t' || isTextRune(r):
+			buf.WriteRune(r)
+			word = false
+		default:
+			word = false
+		}
+	}
+	return buf.String()
+}
 
-	return s
+// isTextRune 是否为汉字, 标点, 空白, 组合符号, 数字或字母
+func isTextRune(r rune) bool {
+	if r < utf8.RuneSelf {
+		return isAlphaNumeric(r) || r == ' ' || unicode.IsPunct(r)
+	}
+	return unicode.In(r, unicode.Han, unicode.P, unicode.Z, unicode.M, unicode.N, unicode.L)
 }
 
-func (p *Dict) romanize(s string, convertName bool) string {
+// romanize 转换为拼音, 同时返回分词结果
+// 音节和其他内容之间以一个空格分隔, 两端没有空格
+func (p *Dict) romanize(s string, convertName bool) (*marked, []word) {
 	s = p.prepare(s)
 
+	var words []word
 	if convertName {
-		for i := 0; i < len(surnames); i += 2 {
-			if strings.Index(s, surnames[i]) == 0 {
-				s = strings.Replace(s, surnames[i], surnames[i+1], 1)
-			}
-		}
+		words = p.segmentName(s)
+	} else {
+		words = p.segment(s)
 	}
 
-	for i := 0; i < len(dict); i += 2 {
-		s = strings.Replace(s, dict[i], dict[i+1], -1)
+	m := &marked{buf: make([]byte, 0, len(s))}
+	for _, w := range words {
+		if w.syllables == nil {
+			m.writeTidy(w.text)
+			continue
+		}
+		for _, syllable := range w.syllables {
+			m.separate()
+			m.writeSyllable(syllable, false)
+		}
 	}
 
-	s = strings.Replace(s, "\t", " ", -1)
-	s = strings.Replace(s, "  ", " ", -1)
-	s = strings.TrimSpace(s)
-
-	return s
+	return m, words
 }
 
 // ToSlice 转换为字符串数组
 func ToSlice(s string) []string {
-	var split []string
-	re := regexp.MustCompile(`[^a-zA-Z1-4]+`)
-	for _, str := range re.Split(s, -1) {
-		if str != "" {
-			split = append(split, str)
+	n := 0
+	for i := 0; i < len(s); {
+		j := i
+		for j < len(s) && isSliceByte(s[j]) {
+			j++
+		}
+		if j > i {
+			n++
+			i = j
+		} else {
+			i++
+		}
+	}
+	if n == 0 {
+		return nil
+	}
+
+	split := make([]string, 0, n)
+	for i := 0; i < len(s); {
+		j := i
+		for j < len(s) && isSliceByte(s[j]) {
+			j++
+		}
+		if j > i {
+			split = append(split, s[i:j])
+			i = j
+		} else {
+			i++
 		}
 	}
 	return split
 }
 
+// isSliceByte 是否为 ToSlice 保留的字符: 字母和声调数字
+func isSliceByte(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '1' && c <= '4')
+}
+
 // ConvertOnlyChinese 只转换中文和繁体字符，保留其他字符
 func (p *Dict) ConvertOnlyChinese(s string, sep string) (result *ConvertResult) {
 	// 处理中文字符
-	var builder strings.Builder
-	
-	// 使用正则表达式匹配中文字符
-	reHan := regexp.MustCompile(`[\p{Han}]+`)
+	var builder marked
 	
 	// 查找所有中文字符的位置
-	indices := reHan.FindAllStringIndex(s, -1)
+	indices := hanRuns(s)
 	
+	var words []word
 	lastIdx := 0
 	for _, idx := range indices {
 		start, end := idx[0], idx[1]
 		
 		// 添加非中文字符
 		if start > lastIdx {
-			builder.WriteString(s[lastIdx:start])
+			builder.writeString(s[lastIdx:start])
+			words = append(words, word{text: s[lastIdx:start]})
 		}
 		
 		// 转换中文字符
 		chineseChars := s[start:end]
-		pinyin := p.romanize(chineseChars, false)
+		pinyin, chineseWords := p.romanize(chineseChars, false)
+		words = append(words, chineseWords...)
 		
-		// 去除两侧空格
-		pinyin = strings.TrimSpace(pinyin)
+		// 确保与前后字符的衔接
+		if r, _ := utf8.DecodeLastRuneInString(s[:start]); start > 0 && needsSeparator(r) {
+			builder.writeString(sep)
+		}
 		
 		// 使用指定分隔符替换空格
-		pinyin = strings.ReplaceAll(pinyin, " ", sep)
-		
-		// 确保与前面字符的衔接
-		if builder.Len() > 0 && isAlphaNumeric(rune(builder.String()[builder.Len()-1])) {
-			builder.WriteString(sep)
+		builder.writeMarked(pinyin, sep)
+
+		if r, _ := utf8.DecodeRuneInString(s[end:]); end < len(s) && needsSeparator(r) {
+			builder.writeString(sep)
 		}
 		
-		builder.WriteString(pinyin)
-		
 		lastIdx = end
 	}
 	
 	// 添加剩余的非中文字符
 	if lastIdx < len(s) {
-		builder.WriteString(s[lastIdx:])
+		builder.writeString(s[lastIdx:])
+		words = append(words, word{text: s[lastIdx:]})
 	}
 	
-	resultString := builder.String()
-	result = NewConvertResult(resultString)
+	result = builder.result(s, words)
 	return
 }
 
 // SentenceOnlyChinese 只转换中文和繁体字符，保留其他字符，包括标点符号和空格
 func (p *Dict) SentenceOnlyChinese(s string) (result *ConvertResult) {
 	// 处理中文字符
-	var builder strings.Builder
-	
-	// 使用正则表达式匹配中文字符
-	reHan := regexp.MustCompile(`[\p{Han}]+`)
+	var builder marked
 	
 	// 查找所有中文字符的位置
-	indices := reHan.FindAllStringIndex(s, -1)
+	indices := hanRuns(s)
 	
+	var words []word
 	lastIdx := 0
 	for _, idx := range indices {
 		start, end := idx[0], idx[1]
 		
 		// 添加非中文字符
 		if start > lastIdx {
-			builder.WriteString(s[lastIdx:start])
+			builder.writeString(s[lastIdx:start])
+			words = append(words, word{text: s[lastIdx:start]})
 		}
 		
 		// 转换中文字符
 		chineseChars := s[start:end]
-		pinyin := p.romanize(chineseChars, false)
+		pinyin, chineseWords := p.romanize(chineseChars, false)
+		words = append(words, chineseWords...)
 		
-		// 去除两侧空格并确保单词间只有一个空格
-		pinyin = strings.TrimSpace(pinyin)
-		re := regexp.MustCompile(`\s+`)
-		pinyin = re.ReplaceAllString(pinyin, " ")
+		// romanize 已去除两侧空格, 单词间只有一个空格
 		
-		// 确保与前面字符的衔接
-		if builder.Len() > 0 && isAlphaNumeric(rune(builder.String()[builder.Len()-1])) {
-			builder.WriteString(" ")
+		// 确保与前后字符的衔接
+		if r, _ := utf8.DecodeLastRuneInString(s[:start]); start > 0 && needsSeparator(r) {
+			builder.writeString(" ")
 		}
 		
-		builder.WriteString(pinyin)
+		builder.writeMarked(pinyin, " ")
+
+		if r, _ := utf8.DecodeRuneInString(s[end:]); end < len(s) && needsSeparator(r) {
+			builder.writeString(" ")
+		}
 		
 		lastIdx = end
 	}
 	
 	// 添加剩余的非中文字符
 	if lastIdx < len(s) {
-		builder.WriteString(s[lastIdx:])
+		builder.writeString(s[lastIdx:])
+		words = append(words, word{text: s[lastIdx:]})
 	}
 	
-	resultString := builder.String()
-	result = NewConvertResult(resultString)
+	result = builder.result(s, words)
 	return
 }
 
+// hanRuns 连续汉字的位置, 每一项为开始和结束的字节位置
+func hanRuns(s string) [][2]int {
+	var runs [][2]int
+	for i := 0; i < len(s); {
+		if n := hanRunLen(s[i:]); n > 0 {
+			runs = append(runs, [2]int{i, i + n})
+			i += n
+			continue
+		}
+		_, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+	}
+	return runs
+}
+
+// needsSeparator 拼音与该字符之间是否需要分隔符, 空白和标点符号不需要
+func needsSeparator(r rune) bool {
+	return !unicode.IsSpace(r) && !unicode.IsPunct(r)
+}
+
+// isLetterByte 是否为 ASCII 字母
+func isLetterByte(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
 // isAlphaNumeric 检查字符是否为字母或数字
 func isAlphaNumeric(r rune) bool {
 	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
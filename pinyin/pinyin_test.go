@@ -1,7 +1,15 @@
 package pinyin
 
 import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"runtime"
+	"strings"
 	"testing"
+	"unicode"
+
+	"pinyin-golang/internal/dictfile"
 )
 
 var (
@@ -129,6 +137,10 @@ func TestDict_Name(t *testing.T) {
 		wantResult *ConvertResult
 	}{
 		{"test", dict, args{`万俟沃喜欢吃酸奶`, " "}, getTestConvertResult("mo4 qi2 wo4 xi3 huan1 chi1 suan1 nai3")},
+		{"single", dict, args{`万里`, " "}, getTestConvertResult("wan4 li3")},
+		{"given_name", dict, args{`乐乐`, " "}, getTestConvertResult("yue4 le4")},
+		{"one_name", dict, args{`单雄信、单田芳`, " "}, getTestConvertResult("shan4 xiong2 xin4 dan1 tian2 fang1")},
+		{"compound", dict, args{`乐正明`, " "}, getTestConvertResult("yue4 zheng4 ming2")},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -161,3 +173,1190 @@ func TestDict_Abbr(t *testing.T) {
 		})
 	}
 }
+
+func TestParsePinyin(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    string
+		wantErr bool
+	}{
+		{"ascii", "lv4", "lv4", false},
+		{"umlaut", "lü4", "lv4", false},
+		{"colon", "lu:4", "lv4", false},
+		{"unicode", "lǜ", "lv4", false},
+		{"upper", "LÜ", "lv", false},
+		{"passport", "lyu", "lv", false},
+		{"combining", "lǜ", "lv4", false},
+		{"combining_tone", "mǎ", "ma3", false},
+		{"lue", "lüè lve4 nüe4", "lue4 lue4 nue4", false},
+		{"jqxy", "jü4 qv4 xüé", "ju4 qu4 xue2", false},
+		{"neutral", "de5 le0 me", "de le me", false},
+		{"joined", "zhong1guo2", "zhong1 guo2", false},
+		{"joined_unicode", "Zhōngguó", "zhong1 guo2", false},
+		{"apostrophe", "xi'an", "xi an", false},
+		{"xian", "xian1", "xian1", false},
+		{"marks", "xīān", "xi1 an1", false},
+		{"erhua", "hua1r r", "hua1 r r", false},
+		{"separators", "wo3, he2-shi2/neng2", "wo3 he2 shi2 neng2", false},
+		{"invalid", "zhong1 gwo2", "", true},
+		{"digit", "3ma", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePinyin(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParsePinyin() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if s := NewConvertResultFromSyllables(got, " ").ASCII(); s != tt.want {
+				t.Errorf("ParsePinyin() = %v, want %v", s, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePinyin_Error(t *testing.T) {
+	_, err := ParsePinyin("zhong1 gwo2")
+	e, ok := err.(*ParseError)
+	if !ok {
+		t.Fatalf("ParsePinyin() error = %v, want *ParseError", err)
+	}
+	if e.Offset != 7 || e.Text != "gwo2" {
+		t.Errorf("ParsePinyin() error = %+v, want offset 7 and text gwo2", e)
+	}
+}
+
+func TestParsePinyin_RoundTrip(t *testing.T) {
+	dict := getTestDict(t)
+	for _, s := range []string{`带着希望去旅行，比到达终点更美好`, `女儿略绿，六月学军`, `万俟沃喜欢吃酸奶`} {
+		cr := dict.Convert(s, " ")
+		want, err := ParsePinyin(cr.ASCII())
+		if err != nil {
+			t.Fatalf("ParsePinyin(%q) error = %v", cr.ASCII(), err)
+		}
+		got, err := ParsePinyin(cr.Unicode())
+		if err != nil {
+			t.Fatalf("ParsePinyin(%q) error = %v", cr.Unicode(), err)
+		}
+		rt := NewConvertResultFromSyllables(got, " ")
+		if !isEqual(rt, NewConvertResultFromSyllables(want, " ")) || rt.Unicode() != cr.Unicode() || rt.None() != cr.None() {
+			t.Errorf("round trip %q = %v, want %v", s, rt.Unicode(), cr.Unicode())
+		}
+	}
+}
+
+func TestConvertResult_Unicode(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{"jue2 xue2 lve4 nv3 lv", "jué xué lüè nǚ lü"},
+		{"liu2 gui4 zhuang1 er2 r", "liú guì zhuāng ér r"},
+		{"Redis shi4 Key-Value", "Redis shì Key-Value"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			if got := NewConvertResult(tt.s).Unicode(); got != tt.want {
+				t.Errorf("ConvertResult.Unicode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertResult_VStyle(t *testing.T) {
+	cr := getTestConvertResult("nv3 lue4 ju2 qu4 xue2 lv lu4")
+	tests := []struct {
+		style       VStyle
+		wantASCII   string
+		wantUnicode string
+		wantNone    string
+	}{
+		{VStyleV, "nv3 lve4 ju2 qu4 xue2 lv lu4", "nv̌ lvè jú qù xué lv lù", "nv lve ju qu xue lv lu"},
+		{VStyleUmlaut, "nü3 lüe4 ju2 qu4 xue2 lü lu4", "nǚ lüè jú qù xué lü lù", "nü lüe ju qu xue lü lu"},
+		{VStyleColon, "nu:3 lu:e4 ju2 qu4 xue2 lu: lu4", "nǔ: lu:è jú qù xué lu: lù", "nu: lu:e ju qu xue lu: lu"},
+		{VStyleYu, "nyu3 lyue4 ju2 qu4 xue2 lyu lu4", "nyǔ lyuè jú qù xué lyu lù", "nyu lyue ju qu xue lyu lu"},
+		{VStyleU, "nu3 lue4 ju2 qu4 xue2 lu lu4", "nǔ luè jú qù xué lu lù", "nu lue ju qu xue lu lu"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.style), func(t *testing.T) {
+			if got := cr.ASCII(WithVStyle(tt.style)); got != tt.wantASCII {
+				t.Errorf("ConvertResult.ASCII() = %v, want %v", got, tt.wantASCII)
+			}
+			if got := cr.Unicode(WithVStyle(tt.style)); got != tt.wantUnicode {
+				t.Errorf("ConvertResult.Unicode() = %v, want %v", got, tt.wantUnicode)
+			}
+			if got := cr.None(WithVStyle(tt.style)); got != tt.wantNone {
+				t.Errorf("ConvertResult.None() = %v, want %v", got, tt.wantNone)
+			}
+		})
+	}
+}
+
+func TestConvertResult_ToneNumber(t *testing.T) {
+	cr := getTestConvertResult("zhuang1 de lv4 lue4 liu2 r m2 Hello")
+	tests := []struct {
+		name string
+		opts []Option
+		want string
+	}{
+		{"default", nil, "zhuang1 de lv4 lue4 liu2 r m2 Hello"},
+		{"after_vowel", []Option{WithTonePosition(ToneAfterVowel)}, "zhua1ng de lv4 lue4 liu2 r m2 Hello"},
+		{"neutral_5", []Option{WithNeutralTone(5)}, "zhuang1 de5 lv4 lue4 liu2 r m2 Hello"},
+		{"neutral_0", []Option{WithNeutralTone(0), WithTonePosition(ToneAfterVowel)}, "zhua1ng de0 lv4 lue4 liu2 r m2 Hello"},
+		{"superscript", []Option{WithSuperscriptTone(), WithNeutralTone(5)}, "zhuang¹ de⁵ lv⁴ lue⁴ liu² r m² Hello"},
+		{"colon", []Option{WithVStyle(VStyleColon), WithTonePosition(ToneAfterVowel)}, "zhua1ng de lu:4 lu:e4 liu2 r m2 Hello"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cr.ASCII(tt.opts...); got != tt.want {
+				t.Errorf("ConvertResult.ASCII() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertResult_String(t *testing.T) {
+	dict := getTestDict(t)
+	cr := dict.Sentence(`重庆，欧阳`)
+	if cr.String() != "chong2 qing4, ou1 yang2" || cr.String() != cr.ASCII() {
+		t.Errorf("ConvertResult = %q, want %q", cr.String(), "chong2 qing4, ou1 yang2")
+	}
+	if got := cr.Unicode(WithCase(CaseUpper)); got != "CHÓNG QÌNG, ŌU YÁNG" {
+		t.Errorf("ConvertResult.Unicode() = %v", got)
+	}
+
+	// 复制后保留音节的位置和首字母大写
+	copied := *dict.Orthographic("西安是一个城市。")
+	want := copied.Unicode()
+	runtime.GC()
+	if got := copied.Unicode(); got != want || !strings.HasPrefix(got, "Xī'ān") {
+		t.Errorf("ConvertResult.Unicode() of copy = %v, want %v", got, want)
+	}
+	if got := (*dict.Convert(`绿色`, " ")).ASCII(WithCase(CaseUpper)); got != "LV4 SE4" {
+		t.Errorf("ConvertResult.ASCII() of copy = %v, want LV4 SE4", got)
+	}
+}
+
+func TestDict_Sentence_NeutralTone(t *testing.T) {
+	dict := getTestDict(t)
+	// Latin text that looks like pinyin is not changed
+	got := dict.Sentence(`a man 的 an`).ASCII(WithNeutralTone(5))
+	if want := "a man de5 an"; got != want {
+		t.Errorf("Dict.Sentence() = %v, want %v", got, want)
+	}
+}
+
+func TestConvertResult_Case(t *testing.T) {
+	dict := getTestDict(t)
+	cr := dict.ConvertOnlyChinese(`iPhone 是 an apple 吗`, " ")
+	tests := []struct {
+		name        string
+		c           Case
+		wantASCII   string
+		wantUnicode string
+	}{
+		{"lower", CaseLower, "iPhone shi4 an apple ma", "iPhone shì an apple ma"},
+		{"upper", CaseUpper, "iPhone SHI4 an apple MA", "iPhone SHÌ an apple MA"},
+		{"title", CaseTitle, "iPhone Shi4 an apple Ma", "iPhone Shì an apple Ma"},
+		{"first_word_title", CaseFirstWordTitle, "iPhone Shi4 an apple ma", "iPhone Shì an apple ma"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cr.ASCII(WithCase(tt.c)); got != tt.wantASCII {
+				t.Errorf("ConvertResult.ASCII() = %v, want %v", got, tt.wantASCII)
+			}
+			if got := cr.Unicode(WithCase(tt.c)); got != tt.wantUnicode {
+				t.Errorf("ConvertResult.Unicode() = %v, want %v", got, tt.wantUnicode)
+			}
+		})
+	}
+
+	if got := dict.Convert(`爱女`, " ").Unicode(WithCase(CaseUpper)); got != "ÀI NǙ" {
+		t.Errorf("ConvertResult.Unicode() = %v, want ÀI NǙ", got)
+	}
+	// 不是转换的结果时不改变大小写, 由音节创建时可以改变
+	if got := NewConvertResult("he is a man shi4").Unicode(WithCase(CaseUpper)); got != "he is a man shì" {
+		t.Errorf("NewConvertResult().Unicode() = %v, want he is a man shì", got)
+	}
+	syllables, _ := ParsePinyin("ni3 hao3")
+	if got := NewConvertResultFromSyllables(syllables, " ").Unicode(WithCase(CaseUpper)); got != "NǏ HǍO" {
+		t.Errorf("NewConvertResultFromSyllables().Unicode() = %v, want NǏ HǍO", got)
+	}
+	if got := (Syllable{Text: "e", Tone: 1}).Unicode(WithCase(CaseTitle)); got != "Ē" {
+		t.Errorf("Syllable.Unicode() = %v, want Ē", got)
+	}
+}
+
+func TestSyllable_InvalidTone(t *testing.T) {
+	for _, tone := range []int{-1, 5, 7, 10} {
+		s := Syllable{Text: "lv", Tone: tone}
+		if got := s.Unicode(); got != "lü" {
+			t.Errorf("Syllable{Tone: %d}.Unicode() = %v, want lü", tone, got)
+		}
+		if got := s.ASCII(WithNeutralTone(5)); got != "lv5" {
+			t.Errorf("Syllable{Tone: %d}.ASCII() = %v, want lv5", tone, got)
+		}
+		if got := s.ASCII(WithVStyle(VStyleColon)); got != "lu:" {
+			t.Errorf("Syllable{Tone: %d}.ASCII() = %v, want lu:", tone, got)
+		}
+	}
+	if got := NewConvertResultFromSyllables([]Syllable{{Text: "ma", Tone: 7}, {Text: "ma", Tone: 3}}, " ").Unicode(); got != "ma mǎ" {
+		t.Errorf("NewConvertResultFromSyllables().Unicode() = %v, want ma mǎ", got)
+	}
+}
+
+func TestDict_Orthographic(t *testing.T) {
+	dict := getTestDict(t)
+	tests := []struct {
+		name        string
+		s           string
+		wantUnicode string
+	}{
+		{"apostrophe", `西安`, "Xī'ān"},
+		{"proper_noun", `我爱中国。`, "Wǒ ài Zhōngguó."},
+		{"word", `音乐很便宜！银行？`, "Yīnyuè hěn piányí! Yínháng?"},
+		{"latin", `Redis 是中国的`, "Redis shì Zhōngguó de"},
+		{"example", `西安是一个城市。`, "Xī'ān shì yígè chéngshì."},
+		{"neutral", `我们的先生`, "Wǒmen de xiānsheng"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dict.Orthographic(tt.s).Unicode(); got != tt.wantUnicode {
+				t.Errorf("Dict.Orthographic() = %v, want %v", got, tt.wantUnicode)
+			}
+		})
+	}
+	if got := dict.Orthographic(`西安`).ASCII(); got != "Xi1an1" {
+		t.Errorf("Dict.Orthographic() = %v, want Xi1an1", got)
+	}
+	if got := dict.Orthographic(`西安`).None(); got != "Xi'an" {
+		t.Errorf("Dict.Orthographic() = %v, want Xi'an", got)
+	}
+}
+
+func TestDict_AddProperNouns(t *testing.T) {
+	dict := getTestDict(t)
+	dict.AddProperNouns(map[string]string{"长安": "", "曾家岩": "zeng1 jia1 yan2"})
+	tests := []struct {
+		s           string
+		wantUnicode string
+	}{
+		{`长安在西安`, "Cháng'ān zài Xī'ān"},
+		{`去曾家岩`, "Qù Zēngjiāyán"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			if got := dict.Orthographic(tt.s).Unicode(); got != tt.wantUnicode {
+				t.Errorf("Dict.Orthographic() = %v, want %v", got, tt.wantUnicode)
+			}
+		})
+	}
+}
+
+func TestDict_ConvertJoined(t *testing.T) {
+	dict := getTestDict(t)
+	tests := []struct {
+		s        string
+		wantNone string
+	}{
+		{`西安`, "xi'an"},
+		{`先`, "xian"},
+		{`方案`, "fang'an"},
+		{`天安门`, "tian'anmen"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			cr := dict.ConvertJoined(tt.s)
+			if got := cr.None(); got != tt.wantNone {
+				t.Errorf("Dict.ConvertJoined() = %v, want %v", got, tt.wantNone)
+			}
+			syllables, err := ParsePinyin(cr.None())
+			if err != nil || len(syllables) != len([]rune(tt.s)) {
+				t.Errorf("ParsePinyin(%v) = %v, %v", cr.None(), syllables, err)
+			}
+		})
+	}
+}
+
+func TestDict_ConvertJoined_Mixed(t *testing.T) {
+	dict := getTestDict(t)
+	tests := []struct {
+		s         string
+		wantASCII string
+	}{
+		{`iPhone是好的abc`, "iPhone shi4hao3de abc"},
+		{`西安abc方案`, "xi1an1 abc fang1an4"},
+		{`Hello, World`, "Hello World"},
+	}
+	for _, tt := range tests {
+		if got := dict.ConvertJoined(tt.s).ASCII(); got != tt.wantASCII {
+			t.Errorf("Dict.ConvertJoined(%q) = %v, want %v", tt.s, got, tt.wantASCII)
+		}
+	}
+}
+
+func TestSplitSyllables(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{"xi'an", "xi|an"},
+		{"xian", "xian"},
+		{"xinan", "xi|nan"},
+		{"fangan", "fan|gan"},
+		{"wo3he2shi2", "wo3|he2|shi2"},
+		{"Xī'ānshì", "Xī|ān|shì"},
+		{"huar yi dian r", "hua|r|yi|dian|r"},
+		{"Redis shi yi ge Key-Value", "Redis|shi|yi|ge|Key|Value"},
+		{"nü3 lu:4", "nü3|lu:4"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			if got := strings.Join(SplitSyllables(tt.s), "|"); got != tt.want {
+				t.Errorf("SplitSyllables() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDict_Names(t *testing.T) {
+	dict := getTestDict(t)
+	got := dict.Names(`张三、欧阳修，单雄信 曾乐`, " ")
+	want := [][4]string{
+		{"张", "三", "zhang1", "san1"},
+		{"欧阳", "修", "ou1 yang2", "xiu1"},
+		{"单", "雄信", "shan4", "xiong2 xin4"},
+		{"曾", "乐", "zeng1", "le4"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Dict.Names() returned %d names, want %d", len(got), len(want))
+	}
+	for i, name := range got {
+		if name.Surname != want[i][0] || name.GivenName != want[i][1] ||
+			name.SurnamePinyin.ASCII() != want[i][2] || name.GivenNamePinyin.ASCII() != want[i][3] {
+			t.Errorf("Dict.Names()[%d] = %v %v %v %v, want %v", i, name.Surname, name.GivenName,
+				name.SurnamePinyin.ASCII(), name.GivenNamePinyin.ASCII(), want[i])
+		}
+	}
+}
+
+func TestDict_Name_GivenNames(t *testing.T) {
+	dict := getTestDict(t)
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"chang", `张长`, "zhang1 chang2"},
+		{"zhao", `张朝阳`, "zhang1 zhao1 yang2"},
+		{"sheng", `王晟`, "wang2 sheng4"},
+		{"compound_surname", `长孙无忌`, "zhang3 sun1 wu2 ji4"},
+		{"word_kuai", `李会计`, "li3 kuai4 ji4"},
+		{"word_hang", `张银行`, "zhang1 yin2 hang2"},
+		{"single_xing", `王行`, "wang2 xing2"},
+		{"single_hui", `刘会`, "liu2 hui4"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dict.Name(tt.s, " ").ASCII(); got != tt.want {
+				t.Errorf("Dict.Name() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDict_AddSurnames(t *testing.T) {
+	dict := NewDict()
+	dict.AddSurnames(map[string]string{"张简": "zhang1 jian3"})
+	dict.AddGivenNames(map[string]string{"朴": "piao2"})
+
+	if got, want := dict.Name(`覃朴`, " ").ASCII(), "qin2 piao2"; got != want {
+		t.Errorf("Dict.Name() = %v, want %v", got, want)
+	}
+	names := dict.Names(`张简明`, " ")
+	if len(names) != 1 || names[0].Surname != "张简" || names[0].GivenName != "明" {
+		t.Errorf("Dict.Names() = %v, want 张简 明", names)
+	}
+	names = NewDict().Names(`张简明`, " ")
+	if len(names) != 1 || names[0].Surname != "张" || names[0].GivenName != "简明" {
+		t.Errorf("Dict.Names() without user tables = %v, want 张 简明", names)
+	}
+	if got, want := NewDict().Name(`覃朴`, " ").ASCII(), "qin2 pu3"; got != want {
+		t.Errorf("Dict.Name() without user tables = %v, want %v", got, want)
+	}
+}
+
+func TestDict_PassportName(t *testing.T) {
+	dict := getTestDict(t)
+	dict.AddCantonese(map[string]string{"陈": "chan", "大文": "tai man", "张": "cheung"})
+	tests := []struct {
+		name    string
+		style   PassportStyle
+		want    string
+		wantErr bool
+	}{
+		{"张三", PassportMainland, "ZHANG SAN", false},
+		{"欧阳修", PassportMainland, "OUYANG XIU", false},
+		{"吕小明", PassportMainland, "LYU XIAOMING", false},
+		{"吕小明", PassportMainlandU, "LU XIAOMING", false},
+		{"单雄信", PassportMainland, "SHAN XIONGXIN", false},
+		{"王小明", PassportTaiwan, "WANG HSIAO-MING", false},
+		{"张启秋", PassportTaiwan, "CHANG CHI-CHIU", false},
+		{"郭国徐", PassportTaiwan, "KUO KUO-HSU", false},
+		{"陈大文", PassportHongKong, "CHAN TAI MAN", false},
+		{"陈小文", PassportHongKong, "", true},
+		{"Tom", PassportMainland, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := dict.PassportName(tt.name, tt.style)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Dict.PassportName() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Dict.PassportName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWadeGiles(t *testing.T) {
+	tests := map[string]string{
+		"zhang": "chang", "xiao": "hsiao", "qiu": "ch'iu", "zi": "tzu", "si": "ssu",
+		"shi": "shih", "ri": "jih", "ge": "ko", "gui": "kuei", "hui": "hui", "duo": "to",
+		"huo": "huo", "shuo": "shuo", "xue": "hsüeh", "lue": "lüeh", "lv": "lü", "jun": "chün",
+		"xian": "hsien", "zhong": "chung", "xiong": "hsiung", "yi": "i", "you": "yu", "cai": "ts'ai",
+		"ren": "jen", "jie": "chieh", "e": "o",
+	}
+	for s, want := range tests {
+		if got := wadeGiles(s); got != want {
+			t.Errorf("wadeGiles(%v) = %v, want %v", s, got, want)
+		}
+	}
+}
+
+func TestDict_SetNumberStyle(t *testing.T) {
+	tests := []struct {
+		name  string
+		style NumberStyle
+		s     string
+		want  string
+	}{
+		{"keep", NumberKeep, `2024年`, "2024 nián"},
+		// 没有设置 SetNormalize 时不改写日期, 时间和金额
+		{"keep_time", NumberKeep, `会议3:45开始`, "huì yì 3: 45 kāi shǐ"},
+		{"keep_version", NumberKeep, `版本2023.1.2发布`, "bǎn běn 2023. 1. 2 fā bù"},
+		{"keep_money", NumberKeep, `售价$5`, "shòu jià 5"},
+		{"keep_score", NumberKeep, `比分10:05`, "bǐ fēn 10: 05"},
+		{"year", NumberAuto, `2024年`, "èr líng èr sì nián"},
+		{"cardinal", NumberAuto, `123`, "yī bǎi èr shí sān"},
+		{"teen", NumberAuto, `15`, "shí wǔ"},
+		{"zero", NumberAuto, `10086`, "yī wàn líng bā shí liù"},
+		{"ten_thousands", NumberAuto, `100000`, "shí wàn"},
+		{"decimal", NumberAuto, `3.14`, "sān diǎn yī sì"},
+		{"percent", NumberAuto, `12.5%`, "bǎi fēn zhī shí èr diǎn wǔ"},
+		{"negative", NumberAuto, `-5度`, "fù wǔ dù"},
+		{"phone", NumberAuto, `13800138000`, "yī sān bā líng líng yī sān bā líng líng líng"},
+		{"phone_yao", NumberAuto | NumberYao, `13800138000`, "yāo sān bā líng líng yāo sān bā líng líng líng"},
+		{"year_yao", NumberAuto | NumberYao, `2011年`, "èr líng yī yī nián"},
+		{"hyphen", NumberAuto | NumberYao, `010-12345678`, "líng yāo líng - yāo èr sān sì wǔ liù qī bā"},
+		{"landline", NumberAuto, `12345678`, "yī qiān èr bǎi sān shí sì wàn wǔ qiān liù bǎi qī shí bā"},
+		{"amount", NumberAuto, `1234500000000元`, "yī wàn èr qiān sān bǎi sì shí wǔ yì yuán"},
+		{"duration", NumberAuto, `过了10年`, "guò le shí nián"},
+		{"year_month", NumberAuto, `98年3月`, "jiǔ bā nián sān yuè"},
+		{"latin", NumberAuto, `mp3和A4纸`, "mp3 hé A4 zhǐ"},
+		{"digits", NumberDigits, `123`, "yī èr sān"},
+		{"cardinal_year", NumberCardinal, `2024年`, "èr qiān líng èr shí sì nián"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dict := NewDict()
+			dict.SetNumberStyle(tt.style)
+			if got := dict.Sentence(tt.s).Unicode(); got != tt.want {
+				t.Errorf("Dict.Sentence() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadCardinal(t *testing.T) {
+	tests := map[string]string{
+		"0":                 "零",
+		"10":                "十",
+		"100000001":         "一亿零一",
+		"101000000":         "一亿零一百万",
+		"1000000000":        "十亿",
+		"999999999999":      "九千九百九十九亿九千九百九十九万九千九百九十九",
+		"1000000000000":     "一万亿",
+		"1234500000000":     "一万二千三百四十五亿",
+		"1000000000010":     "一万亿零一十",
+		"9999999999999999":  "九千九百九十九万九千九百九十九亿九千九百九十九万九千九百九十九",
+		"10000000000000000": "一零零零零零零零零零零零零零零零零",
+	}
+	for s, want := range tests {
+		if got := readCardinal(s); got != want {
+			t.Errorf("readCardinal(%v) = %v, want %v", s, got, want)
+		}
+	}
+}
+
+func TestDict_SetNormalize(t *testing.T) {
+	dict := NewDict()
+	dict.SetNormalize(true)
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"money", `门票¥80。`, "mén piào 80 yuán."},
+		{"date", `2024-10-09`, "2024 nián 10 yuè 9 rì"},
+		{"time", `3:05`, "3 diǎn 5 fēn"},
+		{"time_seconds", `03:05:09`, "3 diǎn 5 fēn 9 miǎo"},
+		{"range", `3-5个`, "3 dào 5 gè"},
+		// 与字母和数字相连, 或不是合法的日期和时间时不改写
+		{"time_digits", `3:456`, "3: 456"},
+		{"date_digits", `2024-10-199`, "2024-10-199"},
+		{"date_latin", `v2024-10-19`, "v2024-10-19"},
+		{"date_month", `2024-13-01`, "2024-13-01"},
+		{"time_hour", `25:00`, "25: 00"},
+		{"range_latin", `3-5a个`, "3-5a gè"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dict.Sentence(tt.s).Unicode(); got != tt.want {
+				t.Errorf("Dict.Sentence() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDict_SetNumberStyle_Normalize(t *testing.T) {
+	dict := NewDict()
+	dict.SetNumberStyle(NumberAuto)
+	dict.SetNormalize(true)
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"chinese_year", `二〇二四年十月`, "èr líng èr sì nián shí yuè"},
+		{"date", `2024-10-19`, "èr líng èr sì nián shí yuè shí jiǔ rì"},
+		{"time", `3:45`, "sān diǎn sì shí wǔ fēn"},
+		{"time_two", `2:00`, "liǎng diǎn"},
+		{"time_seconds", `03:05:30`, "sān diǎn líng wǔ fēn sān shí miǎo"},
+		{"yuan", `¥12.50`, "shí èr yuán wǔ jiǎo"},
+		{"yuan_fen", `￥12.05`, "shí èr yuán líng wǔ fēn"},
+		{"jiao", `¥0.5`, "wǔ jiǎo"},
+		{"dollar", `$5.50`, "wǔ diǎn wǔ měi yuán"},
+		{"percent", `10%`, "bǎi fēn zhī shí"},
+		{"ordinal", `第3名`, "dì sān míng"},
+		{"range", `3-5个`, "sān dào wǔ gè"},
+		{"percent_range", `10~20%`, "bǎi fēn zhī shí dào bǎi fēn zhī èr shí"},
+		{"sentence", `门票¥80。`, "mén piào bā shí yuán."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dict.Sentence(tt.s).Unicode(); got != tt.want {
+				t.Errorf("Dict.Sentence() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDict_Sentence_Punctuation(t *testing.T) {
+	dict := getTestDict(t)
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"quotes", `他说：“你好。”我说「再见」。`, `ta1 shuo1: "ni3 hao3." wo3 shuo1 [zai4 jian4].`},
+		{"brackets", `《红楼梦》（曹雪芹）写于清朝……`, "<hong2 lou2 meng4> (cao2 xue3 qin2) xie3 yu2 qing1 chao2..."},
+		{"leading_bracket", `【重要】通知`, "[zhong4 yao4] tong1 zhi1"},
+		{"quote_comma", `他说“好”，然后走了`, `ta1 shuo1 "hao3", ran2 hou4 zou3 le`},
+		{"dash", `我——你`, "wo3-ni3"},
+		{"latin", `iPhone（苹果）很好`, "iPhone (ping2 guo3) hen3 hao3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dict.Sentence(tt.s).ASCII(); got != tt.want {
+				t.Errorf("Dict.Sentence() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDict_Sentence_PunctuationMap(t *testing.T) {
+	dict := getTestDict(t)
+	m := DefaultPunctuationMap()
+	m["「"], m["」"] = `"`, `"`
+	m["，"], m["。"], m["、"] = "，", "。", "、"
+
+	got := dict.Sentence(`我说「再见」，苹果、香蕉。`, m).Unicode()
+	if want := `wǒ shuō "zài jiàn"，píng guǒ、xiāng jiāo。`; got != want {
+		t.Errorf("Dict.Sentence() = %q, want %q", got, want)
+	}
+	if got, want := DefaultPunctuationMap()["「"], "["; got != want {
+		t.Errorf("DefaultPunctuationMap() was modified: %q, want %q", got, want)
+	}
+}
+
+func TestDict_SetKeepWidth(t *testing.T) {
+	dict := NewDict()
+	if got, want := dict.Convert(`型号ＡＢＣ１２３`, " ").ASCII(), "xing2 hao4 ABC123"; got != want {
+		t.Errorf("Dict.Convert() = %q, want %q", got, want)
+	}
+	if got, want := dict.Sentence(`你好，ＡＢＣ！（测试）　ｶﾞｲﾄﾞ｡`).ASCII(), "ni3 hao3, ABC! (ce4 shi4)."; got != want {
+		t.Errorf("Dict.Sentence() = %q, want %q", got, want)
+	}
+	dict.SetKeepWidth(true)
+	if got, want := dict.Convert(`型号ＡＢＣ１２３`, " ").ASCII(), "xing2 hao4"; got != want {
+		t.Errorf("Dict.Convert() with SetKeepWidth = %q, want %q", got, want)
+	}
+}
+
+func TestFoldWidth(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{"ＡＢＣ１２３", "ABC123"},
+		{"ａ　ｂ", "a b"},
+		{"＄５，－３", "$5，-3"},
+		{"ｶﾞｲﾄﾞ｡", "ガイド。"},
+		{"ﾊﾟﾝ", "パン"},
+	}
+	for _, tt := range tests {
+		if got := foldWidth(tt.s); got != tt.want {
+			t.Errorf("foldWidth(%q) = %q, want %q", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestDict_Mixed(t *testing.T) {
+	dict := getTestDict(t)
+	tests := []struct {
+		name string
+		s    string
+		sep  string
+		opts []MixedOption
+		want string
+	}{
+		{"accented", `café很好`, " ", nil, "café hěn hǎo"},
+		{"digits", `第3名`, " ", nil, "dì 3 míng"},
+		{"spaces", `我  love  你`, " ", nil, "wǒ  love  nǐ"},
+		{"emoji", `我😀你`, " ", nil, "wǒ 😀 nǐ"},
+		{"punctuation", `你好，世界`, " ", nil, "nǐ hǎo，shì jiè"},
+		{"boundary", `我爱café`, "", []MixedOption{WithBoundary("_")}, "wǒài_café"},
+		{"drop", `我爱café和タワー`, " ", []MixedOption{WithDropScripts(unicode.Latin, unicode.Katakana)}, "wǒ ài hé ー"},
+		{"keep", `我爱café和タワー!`, " ", []MixedOption{WithKeepScripts(unicode.Latin)}, "wǒ ài café hé"},
+		// 原文中的控制字符按原样保留, 不被当作音节
+		{"control", "ab\x01\x02好\x03", " ", nil, "ab\x01\x02 hǎo \x03"},
+		{"control_pinyin", "hao3\x01好", " ", nil, "hao3\x01 hǎo"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dict.Mixed(tt.s, tt.sep, tt.opts...).Unicode(); got != tt.want {
+				t.Errorf("Dict.Mixed() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDict_ConvertOnlyChinese_Boundary(t *testing.T) {
+	dict := getTestDict(t)
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{`Nihao我的朋友!`, "Nihao-wo3-de-peng2-you3!"},
+		{`café很好`, "café-hen3-hao3"},
+		{`变换123`, "bian4-huan4-123"},
+		{`我😀你`, "wo3-😀-ni3"},
+		// 原文中的控制字符按原样保留, 不影响音节的位置
+		{"\x02好\x01", "\x02-hao3-\x01"},
+	}
+	for _, tt := range tests {
+		if got := dict.ConvertOnlyChinese(tt.s, "-").ASCII(); got != tt.want {
+			t.Errorf("Dict.ConvertOnlyChinese(%q) = %q, want %q", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestDict_Slug(t *testing.T) {
+	dict := getTestDict(t)
+	long := `音乐很便宜，银行在西安市中心的长江路上`
+	tests := []struct {
+		name string
+		s    string
+		opts []SlugOption
+		want string
+	}{
+		{"words", `音乐很便宜！`, nil, "yinyue-hen-pianyi"},
+		{"latin", `Café 西安 / 银行?*`, nil, "cafe-xian-yinhang"},
+		{"v", `  绿色——女孩  `, nil, "lv-se-nv-hai"},
+		{"unsafe", `《长江》：<script>`, nil, "changjiang-script"},
+		{"separator", `音乐很便宜`, []SlugOption{WithSlugSeparator("_")}, "yinyue_hen_pianyi"},
+		{"truncate", long, []SlugOption{WithSlugMaxLength(20)}, "yinyue-hen-pianyi"},
+		{"hash", long, []SlugOption{WithSlugMaxLength(30), WithSlugHash()}, "yinyue-hen-pianyi-9dc147ff"},
+		{"short", long, []SlugOption{WithSlugMaxLength(3)}, "yin"},
+		{"hash_only", long, []SlugOption{WithSlugMaxLength(9), WithSlugHash()}, "9dc147ff"},
+		{"hash_dropped", long, []SlugOption{WithSlugMaxLength(5), WithSlugHash()}, "yinyu"},
+		{"hash_one", long, []SlugOption{WithSlugMaxLength(1), WithSlugHash()}, "y"},
+		{"exact", long, []SlugOption{WithSlugMaxLength(17)}, "yinyue-hen-pianyi"},
+		{"below_exact", long, []SlugOption{WithSlugMaxLength(16)}, "yinyue-hen"},
+		{"hash_exact", long, []SlugOption{WithSlugMaxLength(26), WithSlugHash()}, "yinyue-hen-pianyi-9dc147ff"},
+		{"hash_below_exact", long, []SlugOption{WithSlugMaxLength(25), WithSlugHash()}, "yinyue-hen-9dc147ff"},
+		{"long_word", `Supercalifragilistic 音乐`, []SlugOption{WithSlugMaxLength(10)}, "supercalif"},
+		{"long_word_hash", `Supercalifragilistic 音乐`, []SlugOption{WithSlugMaxLength(20), WithSlugHash()}, "ba1a06f4"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dict.Slug(tt.s, tt.opts...); got != tt.want {
+				t.Errorf("Dict.Slug() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDict_LoadWords(t *testing.T) {
+	dict := NewDict()
+	if err := dict.LoadWords(strings.NewReader("# 用户词典\n重庆 chong2 qing4\n\n")); err != nil {
+		t.Fatalf("Dict.LoadWords() error = %v", err)
+	}
+	if got, want := dict.Convert(`重庆银行`, " ").ASCII(), "chong2 qing4 yin2 hang2"; got != want {
+		t.Errorf("Dict.Convert() = %v, want %v", got, want)
+	}
+
+	for _, s := range []string{"重庆 chong2\n", "重庆\n", "重庆 chong9 qing4\n"} {
+		if err := NewDict().LoadWords(strings.NewReader(s)); err == nil || !strings.Contains(err.Error(), "line 1") {
+			t.Errorf("Dict.LoadWords(%q) error = %v, want line 1 error", s, err)
+		}
+	}
+}
+
+func TestDict_Heteronyms(t *testing.T) {
+	dict := getTestDict(t)
+	got := dict.Heteronyms(`六a`)
+	if len(got) != 1 || len(got[0]) < 2 || got[0][0].ASCII() != "liu4" || got[0][1].ASCII() != "lu4" {
+		t.Errorf("Dict.Heteronyms() = %v, want [[liu4 lu4]]", got)
+	}
+}
+
+func TestDict_Tokens(t *testing.T) {
+	dict := getTestDict(t)
+	var got []string
+	for _, token := range dict.Tokens(`我爱北京, iPhone`) {
+		var syllables []string
+		for _, s := range token.Syllables {
+			syllables = append(syllables, s.ASCII())
+		}
+		got = append(got, token.Text+"/"+strings.Join(syllables, " "))
+	}
+	want := []string{"我/wo3", "爱/ai4", "北京/bei3 jing1", ", iPhone/"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("Dict.Tokens() = %q, want %q", got, want)
+	}
+}
+
+func TestConvertResult_MarshalJSON(t *testing.T) {
+	dict := getTestDict(t)
+	r := dict.Convert(`重庆银行, OK`, " ")
+	b, err := json.Marshal(r)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	var got ResultJSON
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if got.Version != ResultVersion || got.Input != `重庆银行, OK` || got.Abbr != "cqyh" {
+		t.Errorf("json.Marshal() = %s", b)
+	}
+	if got.Pinyin.ASCII != "chong2 qing4 yin2 hang2 OK" || got.Pinyin.Unicode != "chóng qìng yín háng OK" || got.Pinyin.None != "chong qing yin hang OK" {
+		t.Errorf("json.Marshal() pinyin = %+v", got.Pinyin)
+	}
+	if len(got.Words) != 3 || got.Words[0].Text != "重庆" || got.Words[2].Text != ", OK" || got.Words[2].Syllables != nil {
+		t.Fatalf("json.Marshal() words = %+v", got.Words)
+	}
+	want := SyllableJSON{Char: "庆", PinyinJSON: PinyinJSON{ASCII: "qing4", Unicode: "qìng", None: "qing"}}
+	if len(got.Words[0].Syllables) != 2 || got.Words[0].Syllables[1] != want {
+		t.Errorf("json.Marshal() syllables = %+v, want %+v", got.Words[0].Syllables, want)
+	}
+
+	// 值和结构体中的值与指针的输出相同
+	if v, _ := json.Marshal(*r); string(v) != string(b) {
+		t.Errorf("json.Marshal(ConvertResult) = %s, want %s", v, b)
+	}
+	if v, _ := json.Marshal(struct{ R ConvertResult }{*r}); string(v) != `{"R":`+string(b)+`}` {
+		t.Errorf("json.Marshal(struct{ R ConvertResult }) = %s, want %s", v, b)
+	}
+
+	// 复制后原来的结果被回收, 输出不变
+	copied := *dict.Convert(`重庆银行, OK`, " ")
+	runtime.GC()
+	if v, _ := json.Marshal(copied); string(v) != string(b) {
+		t.Errorf("json.Marshal(copy) after GC = %s, want %s", v, b)
+	}
+
+	if b, _ := json.Marshal(NewConvertResult("mei3 hao3")); string(b) != `{"version":1,"input":"","pinyin":{"ascii":"mei3 hao3","unicode":"měi hǎo","none":"mei hao"},"abbr":"mh","words":[]}` {
+		t.Errorf("json.Marshal(NewConvertResult()) = %s", b)
+	}
+	if b, _ := dict.Name(`欧阳修`, " ").MarshalText(); string(b) != "ōu yáng xiū" {
+		t.Errorf("ConvertResult.MarshalText() = %s, want ōu yáng xiū", b)
+	}
+	if b, _ := (*dict.Name(`欧阳修`, " ")).MarshalText(); string(b) != "ōu yáng xiū" {
+		t.Errorf("ConvertResult.MarshalText() of value = %s, want ōu yáng xiū", b)
+	}
+}
+
+func TestFill(t *testing.T) {
+	type Tag struct {
+		Text       string
+		TextPinyin string `pinyin:"from=Text,style=ascii,sep=-,v=v"`
+	}
+	type User struct {
+		Name       string
+		NamePinyin string `pinyin:"from=Name,name,style=none,sep= "`
+		NameAbbr   string `pinyin:"from=Name,name,abbr"`
+		TextAbbr   string `pinyin:"from=Name,abbr"`
+		Tags       []Tag
+		Friends    []*User
+		Next       *User
+	}
+
+	u := &User{Name: "单雄信", Tags: []Tag{{Text: "女儿"}}, Friends: []*User{{Name: "曾乐"}}}
+	u.Next = u
+	if err := Fill(u); err != nil {
+		t.Fatalf("Fill() error = %v", err)
+	}
+	got := []string{u.NamePinyin, u.NameAbbr, u.TextAbbr, u.Tags[0].TextPinyin, u.Friends[0].NamePinyin}
+	want := []string{"shan xiong xin", "sxx", "dxx", "nv3-er2", "zeng le"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("Fill() = %q, want %q", got, want)
+	}
+
+	var bad struct {
+		Name   string
+		Pinyin int `pinyin:"from=Name"`
+	}
+	if err := Fill(&bad); err == nil {
+		t.Errorf("Fill() error = nil, want error for int target")
+	}
+	if err := Fill(u.Tags[0]); err == nil {
+		t.Errorf("Fill() error = nil, want error for non-pointer")
+	}
+	var unknown struct {
+		Name   string
+		Pinyin string `pinyin:"from=Name,tone=none"`
+	}
+	if err := Fill(&unknown); err == nil {
+		t.Errorf("Fill() error = nil, want error for unknown option")
+	}
+}
+
+func TestFill_SameAddress(t *testing.T) {
+	type Inner struct {
+		Name       string
+		NamePinyin string `pinyin:"from=Name,style=none"`
+	}
+	type Outer struct {
+		Inner       Inner
+		Title       string
+		TitlePinyin string `pinyin:"from=Title,style=none"`
+	}
+	// Inner 指向 Outer 的第一个字段, 两个指针的地址相同
+	o := &Outer{Inner: Inner{Name: "重庆"}, Title: "银行"}
+	v := struct {
+		Inner *Inner
+		Outer *Outer
+	}{&o.Inner, o}
+	if err := Fill(&v); err != nil {
+		t.Fatalf("Fill() error = %v", err)
+	}
+	if o.Inner.NamePinyin != "chong qing" || o.TitlePinyin != "yin hang" {
+		t.Errorf("Fill() = %q, %q, want %q, %q", o.Inner.NamePinyin, o.TitlePinyin, "chong qing", "yin hang")
+	}
+}
+
+func readDictText(t *testing.T, name string) []dictfile.Entry {
+	f, err := os.Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	entries, err := dictfile.ReadText(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return entries
+}
+
+// readBuiltinText 读取生成内置词典的文本
+func readBuiltinText(t *testing.T) []dictfile.Entry {
+	return dictfile.Merge(readDictText(t, "data/dict.txt"), readDictText(t, "data/overrides.txt"))
+}
+
+func TestBuiltinDict_Generated(t *testing.T) {
+	data, err := dictfile.Encode(readBuiltinText(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, dictData) {
+		t.Fatal("dict.bin is out of date, run go generate")
+	}
+}
+
+func TestBuiltinDict_Lookup(t *testing.T) {
+	entries := readBuiltinText(t)
+	d := builtinDict()
+	if d.Len() != len(entries) {
+		t.Fatalf("Len() = %d, want %d", d.Len(), len(entries))
+	}
+	for i, e := range entries {
+		pos, priority, found, _ := d.Lookup(e.Word)
+		if !found || priority != i {
+			t.Fatalf("Lookup(%q) = %v, %d, want true, %d", e.Word, found, priority, i)
+		}
+		if got := d.AppendSyllables(nil, pos); strings.Join(got, " ") != strings.Join(e.Syllables, " ") {
+			t.Fatalf("AppendSyllables(%q) = %v, want %v", e.Word, got, e.Syllables)
+		}
+	}
+	for i, e := range d.Entries() {
+		if e.Word != entries[i].Word {
+			t.Fatalf("Entries()[%d] = %q, want %q", i, e.Word, entries[i].Word)
+		}
+	}
+
+	tests := []struct {
+		word          string
+		found, longer bool
+	}{
+		{"重庆", true, true},
+		{"踉踉", false, true},
+		{"踉踉跄跄", true, false},
+		{"abc", false, false},
+		{"", false, true},
+		{"\U0010FFFF", false, false},
+	}
+	for _, tt := range tests {
+		if _, _, found, longer := d.Lookup(tt.word); found != tt.found || longer != tt.longer {
+			t.Errorf("Lookup(%q) = %v, %v, want %v, %v", tt.word, found, longer, tt.found, tt.longer)
+		}
+	}
+}
+
+func TestMergeDictEntries(t *testing.T) {
+	entry := func(word string, syllables ...string) dictfile.Entry {
+		return dictfile.Entry{Word: word, Syllables: syllables}
+	}
+	entries := []dictfile.Entry{
+		entry("长", "chang2"), entry("长大", "zhang3", "da4"), entry("行", "xing2"),
+		entry("银行", "yin2", "hang2"), entry("长", "zhang3"), entry("一行", "yi1", "xing2"),
+	}
+	overrides := []dictfile.Entry{entry("行", "hang2"), entry("一行", dictfile.Delete), entry("重庆", "chong2", "qing4")}
+	var got []string
+	for _, e := range dictfile.Merge(entries, overrides) {
+		got = append(got, e.Word+" "+strings.Join(e.Syllables, " "))
+	}
+	want := []string{"重庆 chong2 qing4", "长大 zhang3 da4", "银行 yin2 hang2", "行 hang2", "长 chang2"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("Merge() = %v, want %v", got, want)
+	}
+}
+
+func TestBuiltinDict_Corrupt(t *testing.T) {
+	for _, n := range []int{0, 4, 20, 2000} {
+		if _, err := dictfile.Open(dictData[:n]); err == nil {
+			t.Errorf("Open(dictData[:%d]) error = nil", n)
+		}
+	}
+}
+
+// benchText 基准测试使用的文本, 包含多音字, 标点, 字母和数字
+const benchText = `带着希望去旅行，比到达终点更美好。我爱北京天安门, iPhone 15 发布会在 2024 年举行！`
+
+func BenchmarkDict_Convert(b *testing.B) {
+	dict := NewDict()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dict.Convert(benchText, " ").ASCII()
+	}
+}
+
+func BenchmarkDict_ConvertJoined(b *testing.B) {
+	dict := NewDict()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dict.ConvertJoined(benchText).None()
+	}
+}
+
+func BenchmarkDict_Sentence(b *testing.B) {
+	dict := NewDict()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dict.Sentence(benchText).Unicode()
+	}
+}
+
+func BenchmarkDict_Sentence_PunctuationMap(b *testing.B) {
+	dict := NewDict()
+	m := DefaultPunctuationMap()
+	m["，"] = "，"
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dict.Sentence(benchText, m).Unicode()
+	}
+}
+
+func BenchmarkDict_ConvertOnlyChinese(b *testing.B) {
+	dict := NewDict()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dict.ConvertOnlyChinese(benchText, " ").None()
+	}
+}
+
+func BenchmarkDict_SentenceOnlyChinese(b *testing.B) {
+	dict := NewDict()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dict.SentenceOnlyChinese(benchText).None()
+	}
+}
+
+func BenchmarkDict_Name(b *testing.B) {
+	dict := NewDict()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dict.Name(`张三、单雄信、欧阳修`, " ").ASCII()
+	}
+}
+
+func BenchmarkDict_Names(b *testing.B) {
+	dict := NewDict()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dict.Names(`张三、单雄信、欧阳修`, " ")
+	}
+}
+
+func BenchmarkDict_PassportName(b *testing.B) {
+	dict := NewDict()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dict.PassportName(`吕单雄`, PassportMainland)
+	}
+}
+
+func BenchmarkDict_Abbr(b *testing.B) {
+	dict := NewDict()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dict.Abbr(benchText, "")
+	}
+}
+
+func BenchmarkDict_Orthographic(b *testing.B) {
+	dict := NewDict()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dict.Orthographic(benchText).Unicode()
+	}
+}
+
+func BenchmarkDict_Mixed(b *testing.B) {
+	dict := NewDict()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dict.Mixed(benchText, " ").Unicode()
+	}
+}
+
+func BenchmarkDict_Slug(b *testing.B) {
+	dict := NewDict()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dict.Slug(benchText)
+	}
+}
+
+func BenchmarkDict_Tokens(b *testing.B) {
+	dict := NewDict()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dict.Tokens(benchText)
+	}
+}
+
+func BenchmarkDict_Heteronyms(b *testing.B) {
+	dict := NewDict()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dict.Heteronyms(benchText)
+	}
+}
+
+func BenchmarkDict_SetNumberStyle(b *testing.B) {
+	dict := NewDict()
+	dict.SetNumberStyle(NumberAuto)
+	dict.SetNormalize(true)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dict.Convert(benchText, " ").ASCII()
+	}
+}
+
+func BenchmarkConvertResult_MarshalJSON(b *testing.B) {
+	result := NewDict().Convert(benchText, " ")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		result.MarshalJSON()
+	}
+}
+
+func BenchmarkNewConvertResult(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		NewConvertResult("dai4 zhe xi1 wang4 qu4 lv3 xing2").Unicode()
+	}
+}
+
+func BenchmarkToSlice(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		ToSlice("dai4 zhe xi1 wang4, qu4 lv3 xing2")
+	}
+}
+
+func BenchmarkParsePinyin(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		ParsePinyin("Dài zhe xīwàng qù lǚxíng")
+	}
+}
+
+func BenchmarkSplitSyllables(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		SplitSyllables("daizhexiwangqulvxing")
+	}
+}
+
+func BenchmarkFill(b *testing.B) {
+	type user struct {
+		Name       string
+		NamePinyin string `pinyin:"from=Name,name"`
+		NameAbbr   string `pinyin:"from=Name,name,abbr"`
+	}
+	dict := NewDict()
+	u := &user{Name: "单雄信"}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dict.Fill(u)
+	}
+}
@@ -0,0 +1,43 @@
+package pinyin
+
+import (
+	"bytes"
+	"sync"
+)
+
+// maxPooledBuffer 放回池中的缓冲区的最大容量, 转换很长的文本后不保留过大的缓冲区
+const maxPooledBuffer = 64 * 1024
+
+// bufferPool 转换过程中使用的缓冲区
+var bufferPool = sync.Pool{
+	New: func() interface{} { return new(bytes.Buffer) },
+}
+
+// getBuffer 从池中取出一个空的缓冲区
+func getBuffer() *bytes.Buffer {
+	buf := bufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	return buf
+}
+
+// putBuffer 将缓冲区放回池中
+func putBuffer(buf *bytes.Buffer) {
+	if buf.Cap() <= maxPooledBuffer {
+		bufferPool.Put(buf)
+	}
+}
+
+// segmenter 分词时使用的临时数据
+type segmenter struct {
+	// offsets 每个字符在文本中的字节位置, 最后一项为文本的长度
+	offsets []int
+	matches []match
+	// accepted 从每个位置开始的词语在 matches 中的下标, 没有时为 -1
+	accepted []int
+	covered  []bool
+}
+
+// segmenterPool 分词时使用的临时数据
+var segmenterPool = sync.Pool{
+	New: func() interface{} { return new(segmenter) },
+}
@@ -0,0 +1,203 @@
+package pinyin
+
+import (
+	"unicode/utf8"
+)
+
+// PunctuationMap 标点符号的映射, 键为中文标点, 值为输出的标点
+// 标点前后的空格按中文标点的类型添加, 如 「」 映射为 " 时仍按引号处理; 输出全角标点时前后不加空格
+type PunctuationMap map[string]string
+
+// DefaultPunctuationMap 默认的标点符号映射, 中文标点转换为英文标点
+// 返回的映射可以修改后传给 Sentence
+func DefaultPunctuationMap() PunctuationMap {
+	m := PunctuationMap{}
+	for i := 0; i < len(punctuations); i += 2 {
+		m[punctuations[i]] = punctuations[i+1]
+	}
+	return m
+}
+
+// punctClass 标点符号的类型, 决定前后是否加空格
+type punctClass int
+
+const (
+	// punctTrailing 点号, 前面不加空格, 后面加空格, 如 ，。
+	punctTrailing punctClass = iota
+	// punctOpen 开引号和开括号, 前面加空格, 后面不加空格, 如 “（
+	punctOpen
+	// punctClose 闭引号和闭括号, 前面不加空格, 后面加空格, 如 ”）
+	punctClose
+	// punctJoin 连接号, 前后都不加空格, 如 —— ～ ·
+	punctJoin
+	// punctWord 其他字符, 前后加空格
+	punctWord
+)
+
+// isFullWidth 是否为全角标点, 全角标点前后不加空格
+func isFullWidth(mark string) bool {
+	for _, r := range mark {
+		if r < 0x2000 {
+			return false
+		}
+	}
+	return true
+}
+
+var (
+	// punctClasses 中文标点的类型, 未列出的标点为 punctTrailing
+	punctClasses = map[string]punctClass{
+		"‘": punctOpen, "“": punctOpen, "「": punctOpen, "『": punctOpen, "（": punctOpen,
+		"〔": punctOpen, "【": punctOpen, "{": punctOpen, "《": punctOpen, "〈": punctOpen,
+		"’": punctClose, "”": punctClose, "」": punctClose, "』": punctClose, "）": punctClose,
+		"〕": punctClose, "】": punctClose, "}": punctClose, "》": punctClose, "〉": punctClose,
+		"——": punctJoin, "—": punctJoin, "/": punctJoin, "\\": punctJoin, "～": punctJoin, "·": punctJoin,
+	}
+
+	// defaultPunctuator 默认的标点符号转换
+	defaultPunctuator = newPunctuator(DefaultPunctuationMap())
+)
+
+// punctuator 标点符号转换
+type punctuator struct {
+	// marks 标点符号的映射
+	marks *table
+	// allowed 标点符号和映射后的标点中的字符, 其他符号被去除
+	allowed map[rune]bool
+}
+
+// newPunctuator 由标点符号映射创建标点符号转换
+func newPunctuator(m PunctuationMap) *punctuator {
+	p := &punctuator{marks: newTable(nil), allowed: map[rune]bool{}}
+	for mark, repl := range m {
+		p.marks.add(mark, repl)
+		for _, r := range mark + repl {
+			p.allowed[r] = true
+		}
+	}
+	return p
+}
+
+// punctuate 使用默认的映射转换标点符号
+func punctuate(m *marked) *marked {
+	return defaultPunctuator.punctuate(m)
+}
+
+// keep 是否保留字符: 字母, 数字, 空白, 下划线和标点符号
+func (p *punctuator) keep(r rune) bool {
+	switch {
+	case isAlphaNumeric(r), r == '_':
+		return true
+	case r == ' ', r == '\t', r == '\n', r == '\v', r == '\f', r == '\r':
+		return true
+	}
+	return p.allowed[r]
+}
+
+// punctuate 去除无法转换的字符, 转换标点符号, 并按标点的类型调整空格
+// 拼音音节按原样保留
+func (p *punctuator) punctuate(m *marked) *marked {
+	s := m.buf
+	out := &marked{buf: make([]byte, 0, len(s)), spans: make([]span, 0, len(m.spans))}
+	// last 上一个输出的类型, 开头视为开括号, 不加空格
+	last := punctOpen
+	space := func(class punctClass) {
+		switch class {
+		case punctWord, punctOpen:
+			if last == punctWord || last == punctTrailing || last == punctClose {
+				out.buf = append(out.buf, ' ')
+			}
+		}
+		last = class
+	}
+
+	// 标点之间的内容按空白切分为单词, 去除无法转换的字符
+	var buf marked
+	writeWords := func() {
+		b := buf.buf
+		k := 0
+		for i := 0; i < len(b); {
+			for i < len(b) && isSpaceByte(b[i]) {
+				i++
+			}
+			j := i
+			for j < len(b) && !isSpaceByte(b[j]) {
+				j++
+			}
+			if j > i {
+				space(punctWord)
+				// 音节不含空白, 总是在一个单词中
+				offset := len(out.buf) - i
+				out.buf = append(out.buf, b[i:j]...)
+				for ; k < len(buf.spans) && buf.spans[k].start < j; k++ {
+					sp := buf.spans[k]
+					sp.start += offset
+					sp.end += offset
+					out.spans = append(out.spans, sp)
+				}
+			}
+			i = j
+		}
+		buf.buf, buf.spans = buf.buf[:0], buf.spans[:0]
+	}
+
+	// offsets 当前位置之后最多 maxLen 个字符的结束位置
+	offsets := make([]int, 0, p.marks.maxLen)
+	k := 0
+	for i := 0; i < len(s); {
+		if k < len(m.spans) && m.spans[k].start == i {
+			sp := m.spans[k]
+			buf.copySyllable(string(s[sp.start:sp.end]), sp)
+			i = sp.end
+			k++
+			continue
+		}
+		// 标点不与后面的音节重叠
+		limit := len(s)
+		if k < len(m.spans) {
+			limit = m.spans[k].start
+		}
+		offsets = offsets[:0]
+		for j := i; j < limit && len(offsets) < p.marks.maxLen; {
+			_, size := utf8.DecodeRune(s[j:])
+			j += size
+			offsets = append(offsets, j)
+		}
+		n := len(offsets)
+		var repl string
+		for ; n > 0; n-- {
+			var ok bool
+			if repl, ok = p.marks.words[string(s[i:offsets[n-1]])]; ok {
+				break
+			}
+		}
+		if n == 0 {
+			r, size := utf8.DecodeRune(s[i:])
+			if p.keep(r) {
+				buf.buf = append(buf.buf, s[i:i+size]...)
+			}
+			i += size
+			continue
+		}
+
+		writeWords()
+		end := offsets[n-1]
+		if repl != "" {
+			class := punctClasses[string(s[i:end])]
+			if class != punctWord && isFullWidth(repl) {
+				class = punctJoin
+			}
+			space(class)
+			out.buf = append(out.buf, repl...)
+		}
+		i = end
+	}
+	writeWords()
+
+	return out
+}
+
+// isSpaceByte 是否为 ASCII 空白
+func isSpaceByte(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\v' || c == '\f' || c == '\r'
+}
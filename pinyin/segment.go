@@ -0,0 +1,208 @@
+package pinyin
+
+import (
+	"sort"
+)
+
+// table 读音表
+type table struct {
+	// words 词语的读音, 音节以空白分隔
+	words map[string]string
+	// maxLen 最长词语的字数
+	maxLen int
+}
+
+// newTable 由 词语, 读音 交替排列的数组创建读音表
+func newTable(pairs []string) *table {
+	t := &table{words: map[string]string{}}
+	for i := 0; i < len(pairs); i += 2 {
+		t.add(pairs[i], pairs[i+1])
+	}
+	return t
+}
+
+// add 添加词语的读音
+func (t *table) add(word, reading string) {
+	t.words[word] = reading
+	if n := len([]rune(word)); n > t.maxLen {
+		t.maxLen = n
+	}
+}
+
+// lookup 查找词语的读音, t 为 nil 时返回 false
+func (t *table) lookup(word string) (string, bool) {
+	if t == nil {
+		return "", false
+	}
+	reading, ok := t.words[word]
+	return reading, ok
+}
+
+// word 分词结果
+type word struct {
+	// text 原文
+	text string
+	// syllables 拼音音节, 不在词典中的内容为 nil
+	syllables []string
+}
+
+// match 词典匹配
+type match struct {
+	// table 读音表的顺序, 词典排在最后
+	table int
+	// priority 在读音表中的优先级, 越小越优先
+	priority int
+	// start, end 在字符中的位置
+	start, end int
+	// pinyin 读音表中的拼音
+	pinyin string
+	// pos 词语在内置词典中的位置, 只用于内置词典
+	pos int
+}
+
+// matchOrder 按读音表的顺序, 优先级和位置排序
+type matchOrder []match
+
+func (m matchOrder) Len() int      { return len(m) }
+func (m matchOrder) Swap(i, j int) { m[i], m[j] = m[j], m[i] }
+func (m matchOrder) Less(i, j int) bool {
+	if m[i].table != m[j].table {
+		return m[i].table < m[j].table
+	}
+	if m[i].priority != m[j].priority {
+		return m[i].priority < m[j].priority
+	}
+	return m[i].start < m[j].start
+}
+
+// segment 按词典分词
+// 词典按词长从长到短排列, 排在前面的词语优先匹配. tables 和用户添加的词语按顺序优先于词典, 读音表中较长的词语优先.
+func (p *Dict) segment(s string, tables ...*table) []word {
+	sg := segmenterPool.Get().(*segmenter)
+	defer segmenterPool.Put(sg)
+
+	offsets := sg.offsets[:0]
+	for i := range s {
+		offsets = append(offsets, i)
+	}
+	n := len(offsets)
+	offsets = append(offsets, len(s))
+	sg.offsets = offsets
+
+	// 按字节位置截取, 查找词典时不分配内存
+	matches := sg.matches[:0]
+	for k := 0; k <= len(tables); k++ {
+		t := p.words
+		if k < len(tables) {
+			t = tables[k]
+		}
+		if t == nil {
+			continue
+		}
+		for i := 0; i < n; i++ {
+			for l := 1; l <= t.maxLen && i+l <= n; l++ {
+				if reading, ok := t.words[s[offsets[i]:offsets[i+l]]]; ok {
+					matches = append(matches, match{table: k, priority: -l, start: i, end: i + l, pinyin: reading})
+				}
+			}
+		}
+	}
+	builtin := builtinDict()
+	for i := 0; i < n; i++ {
+		for l := 1; l <= builtin.MaxWordLen() && i+l <= n; l++ {
+			pos, priority, found, longer := builtin.Lookup(s[offsets[i]:offsets[i+l]])
+			if found {
+				matches = append(matches, match{table: len(tables) + 1, priority: priority, start: i, end: i + l, pos: pos})
+			}
+			if !longer {
+				break
+			}
+		}
+	}
+	sort.Sort(matchOrder(matches))
+	sg.matches = matches
+
+	// 按优先级选出互不重叠的词语
+	if cap(sg.covered) < n {
+		sg.covered = make([]bool, n)
+		sg.accepted = make([]int, n)
+	}
+	covered, accepted := sg.covered[:n], sg.accepted[:n]
+	for i := range covered {
+		covered[i], accepted[i] = false, -1
+	}
+	syllables := 0
+	for k := range matches {
+		m := &matches[k]
+		free := true
+		for i := m.start; i < m.end; i++ {
+			if covered[i] {
+				free = false
+				break
+			}
+		}
+		if !free {
+			continue
+		}
+		for i := m.start; i < m.end; i++ {
+			covered[i] = true
+		}
+		accepted[m.start] = k
+		syllables += m.end - m.start
+	}
+
+	// 所有词语的音节共用一个数组
+	var words []word
+	all := make([]string, 0, syllables)
+	last := 0
+	for i := 0; i < n; {
+		if accepted[i] < 0 {
+			i++
+			continue
+		}
+		m := &matches[accepted[i]]
+		if last < i {
+			words = append(words, word{text: s[offsets[last]:offsets[i]]})
+		}
+		start := len(all)
+		if m.table > len(tables) {
+			all = builtin.AppendSyllables(all, m.pos)
+		} else {
+			all = appendFields(all, m.pinyin)
+		}
+		words = append(words, word{text: s[offsets[m.start]:offsets[m.end]], syllables: all[start:len(all):len(all)]})
+		i = m.end
+		last = i
+	}
+	if last < n {
+		words = append(words, word{text: s[offsets[last]:]})
+	}
+
+	// 不保留读音的引用
+	for k := range matches {
+		matches[k].pinyin = ""
+	}
+	return words
+}
+
+// appendFields 将以空白分隔的音节追加到 dst, 与 strings.Fields 相同, 读音只包含 ASCII 字符
+func appendFields(dst []string, s string) []string {
+	start := -1
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case ' ', '\t', '\n', '\r', '\v', '\f':
+			if start >= 0 {
+				dst = append(dst, s[start:i])
+				start = -1
+			}
+		default:
+			if start < 0 {
+				start = i
+			}
+		}
+	}
+	if start >= 0 {
+		dst = append(dst, s[start:])
+	}
+	return dst
+}
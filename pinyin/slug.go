@@ -0,0 +1,136 @@
+package pinyin
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+)
+
+var (
+	// latinFolds 常见的带变音符号的拉丁字母, 转换为不带变音符号的字母
+	latinFolds = []string{
+		"à", "a", "á", "a", "â", "a", "ã", "a", "ä", "a", "å", "a", "ā", "a", "ă", "a", "ą", "a", "æ", "ae",
+		"ç", "c", "ć", "c", "č", "c", "ď", "d", "đ", "d", "ð", "d",
+		"è", "e", "é", "e", "ê", "e", "ë", "e", "ē", "e", "ė", "e", "ę", "e", "ě", "e",
+		"ì", "i", "í", "i", "î", "i", "ï", "i", "ī", "i", "į", "i", "ı", "i",
+		"ł", "l", "ñ", "n", "ń", "n", "ň", "n",
+		"ò", "o", "ó", "o", "ô", "o", "õ", "o", "ö", "o", "ø", "o", "ō", "o", "ő", "o", "œ", "oe",
+		"ř", "r", "ś", "s", "š", "s", "ş", "s", "ß", "ss", "ť", "t", "þ", "th",
+		"ù", "u", "ú", "u", "û", "u", "ü", "u", "ū", "u", "ů", "u", "ű", "u",
+		"ý", "y", "ÿ", "y", "ź", "z", "ż", "z", "ž", "z",
+	}
+
+	// latinFolder 去除拉丁字母的变音符号
+	latinFolder = strings.NewReplacer(latinFolds...)
+)
+
+// slugHashLen 哈希的字节数
+const slugHashLen = 8
+
+// SlugOption Slug 的选项
+type SlugOption func(*slugOptions)
+
+// slugOptions Slug 的选项
+type slugOptions struct {
+	// sep 单词之间的分隔符
+	sep string
+	// maxLength 最大字节数, 为 0 时不限制
+	maxLength int
+	// hash 截断时是否添加哈希
+	hash bool
+}
+
+// WithSlugSeparator 单词之间的分隔符, 默认为 -
+func WithSlugSeparator(sep string) SlugOption {
+	return func(o *slugOptions) {
+		o.sep = sep
+	}
+}
+
+// WithSlugMaxLength 最大字节数, 超过时在单词边界截断, 第一个单词也放不下时截断第一个单词
+func WithSlugMaxLength(n int) SlugOption {
+	return func(o *slugOptions) {
+		o.maxLength = n
+	}
+}
+
+// WithSlugHash 截断时在末尾添加 8 位十六进制哈希, 由完整的 slug 计算, 同一标题的结果保持不变
+// 最大字节数放不下完整的单词和哈希时只输出哈希, 小于 8 时不添加哈希, 结果不超过最大字节数
+func WithSlugHash() SlugOption {
+	return func(o *slugOptions) {
+		o.hash = true
+	}
+}
+
+// Slug 生成用于 URL 和文件名的 slug
+// 汉字按词语转换为不带声调的拼音, 保留拉丁字母和数字, 其他字符视为分隔符, 结果只包含小写字母, 数字和分隔符
+// 音乐很便宜！ → yinyue-hen-pianyi, iPhone 15 发布会 → iphone-15-fabuhui
+func (p *Dict) Slug(s string, opts ...SlugOption) string {
+	o := &slugOptions{sep: "-"}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	var words []string
+	for _, w := range p.segmentWords(p.prepare(s)) {
+		if w.syllables == nil {
+			words = append(words, slugFields(w.text)...)
+			continue
+		}
+		var builder strings.Builder
+		for _, syllable := range w.syllables {
+			if syl, ok := tokenSyllable(syllable); ok {
+				builder.WriteString(syl.None(WithVStyle(VStyleV)))
+			}
+		}
+		if builder.Len() > 0 {
+			words = append(words, builder.String())
+		}
+	}
+
+	slug := strings.Join(words, o.sep)
+	if o.maxLength <= 0 || len(slug) <= o.maxLength {
+		return slug
+	}
+
+	// 哈希放不下时不添加哈希
+	if o.hash && o.maxLength >= slugHashLen {
+		h := fnv.New32a()
+		h.Write([]byte(slug))
+		suffix := fmt.Sprintf("%08x", h.Sum32())
+		// 放不下单词时只输出哈希
+		if truncated := truncateWords(words, o.sep, o.maxLength-len(o.sep)-len(suffix)); truncated != "" {
+			return truncated + o.sep + suffix
+		}
+		return suffix
+	}
+	if truncated := truncateWords(words, o.sep, o.maxLength); truncated != "" {
+		return truncated
+	}
+	// 第一个单词也放不下时截断第一个单词
+	return words[0][:o.maxLength]
+}
+
+// slugFields 将非拼音的内容小写, 去除变音符号, 并按字母和数字以外的字符切分
+func slugFields(s string) []string {
+	s = latinFolder.Replace(strings.ToLower(s))
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z') && !(r >= '0' && r <= '9')
+	})
+}
+
+// truncateWords 保留不超过 limit 字节的完整单词, 第一个单词也放不下时返回空字符串
+func truncateWords(words []string, sep string, limit int) string {
+	length := 0
+	for i, w := range words {
+		n := len(w)
+		if i > 0 {
+			n += len(sep)
+		}
+		if length+n > limit {
+			return strings.Join(words[:i], sep)
+		}
+		length += n
+	}
+	return strings.Join(words, sep)
+}
@@ -0,0 +1,510 @@
+package pinyin
+
+import (
+	"fmt"
+	"strings"
+)
+
+var (
+	// syllables 音节表 (不带声调, ü 写作 v)
+	syllables = []string{
+		// 零声母
+		"a", "ai", "an", "ang", "ao", "e", "ei", "en", "eng", "er", "o", "ou",
+		// b
+		"ba", "bai", "ban", "bang", "bao", "bei", "ben", "beng", "bi", "bian", "biao", "bie", "bin", "bing", "bo", "bu",
+		// p
+		"pa", "pai", "pan", "pang", "pao", "pei", "pen", "peng", "pi", "pian", "piao", "pie", "pin", "ping", "po", "pou", "pu",
+		// m
+		"ma", "mai", "man", "mang", "mao", "me", "mei", "men", "meng", "mi", "mian", "miao", "mie", "min", "ming", "miu", "mo", "mou", "mu",
+		// f
+		"fa", "fan", "fang", "fei", "fen", "feng", "fiao", "fo", "fou", "fu",
+		// d
+		"da", "dai", "dan", "dang", "dao", "de", "dei", "den", "deng", "di", "dia", "dian", "diao", "die", "ding", "diu", "dong", "dou", "du", "duan", "dui", "dun", "duo",
+		// t
+		"ta", "tai", "tan", "tang", "tao", "te", "teng", "ti", "tian", "tiao", "tie", "ting", "tong", "tou", "tu", "tuan", "tui", "tun", "tuo",
+		// n
+		"na", "nai", "nan", "nang", "nao", "ne", "nei", "nen", "neng", "ni", "nian", "niang", "niao", "nie", "nin", "ning", "niu", "nong", "nou", "nu", "nuan", "nue", "nun", "nuo", "nv",
+		// l
+		"la", "lai", "lan", "lang", "lao", "le", "lei", "leng", "li", "lia", "lian", "liang", "liao", "lie", "lin", "ling", "liu", "lo", "long", "lou", "lu", "luan", "lue", "lun", "luo", "lv",
+		// g
+		"ga", "gai", "gan", "gang", "gao", "ge", "gei", "gen", "geng", "gong", "gou", "gu", "gua", "guai", "guan", "guang", "gui", "gun", "guo",
+		// k
+		"ka", "kai", "kan", "kang", "kao", "ke", "kei", "ken", "keng", "kong", "kou", "ku", "kua", "kuai", "kuan", "kuang", "kui", "kun", "kuo",
+		// h
+		"ha", "hai", "han", "hang", "hao", "he", "hei", "hen", "heng", "hong", "hou", "hu", "hua", "huai", "huan", "huang", "hui", "hun", "huo",
+		// j
+		"ji", "jia", "jian", "jiang", "jiao", "jie", "jin", "jing", "jiong", "jiu", "ju", "juan", "jue", "jun",
+		// q
+		"qi", "qia", "qian", "qiang", "qiao", "qie", "qin", "qing", "qiong", "qiu", "qu", "quan", "que", "qun",
+		// x
+		"xi", "xia", "xian", "xiang", "xiao", "xie", "xin", "xing", "xiong", "xiu", "xu", "xuan", "xue", "xun",
+		// zh
+		"zha", "zhai", "zhan", "zhang", "zhao", "zhe", "zhei", "zhen", "zheng", "zhi", "zhong", "zhou", "zhu", "zhua", "zhuai", "zhuan", "zhuang", "zhui", "zhun", "zhuo",
+		// ch
+		"cha", "chai", "chan", "chang", "chao", "che", "chen", "cheng", "chi", "chong", "chou", "chu", "chua", "chuai", "chuan", "chuang", "chui", "chun", "chuo",
+		// sh
+		"sha", "shai", "shan", "shang", "shao", "she", "shei", "shen", "sheng", "shi", "shou", "shu", "shua", "shuai", "shuan", "shuang", "shui", "shun", "shuo",
+		// r
+		"ran", "rang", "rao", "re", "ren", "reng", "ri", "rong", "rou", "ru", "rua", "ruan", "rui", "run", "ruo",
+		// z
+		"za", "zai", "zan", "zang", "zao", "ze", "zei", "zen", "zeng", "zi", "zong", "zou", "zu", "zuan", "zui", "zun", "zuo",
+		// c
+		"ca", "cai", "can", "cang", "cao", "ce", "cen", "ceng", "ci", "cong", "cou", "cu", "cuan", "cui", "cun", "cuo",
+		// s
+		"sa", "sai", "san", "sang", "sao", "se", "sen", "seng", "si", "song", "sou", "su", "suan", "sui", "sun", "suo",
+		// y
+		"ya", "yan", "yang", "yao", "ye", "yi", "yin", "ying", "yo", "yong", "you", "yu", "yuan", "yue", "yun",
+		// w
+		"wa", "wai", "wan", "wang", "wei", "wen", "weng", "wo", "wu",
+		// 叹词
+		"m", "n", "ng", "hm", "hng",
+		// 儿化
+		"r",
+	}
+
+	// syllableAliases 音节的其他写法
+	syllableAliases = map[string]string{
+		// lüe/nüe 也写作 lve/nve
+		"lve": "lue", "nve": "nue",
+		// 护照拼写
+		"lyu": "lv", "nyu": "nv", "lyue": "lue", "nyue": "nue",
+	}
+
+	// toneMarks 带声调的元音
+	toneMarks = map[rune][2]rune{
+		'ā': {'a', 1}, 'á': {'a', 2}, 'ǎ': {'a', 3}, 'à': {'a', 4},
+		'ō': {'o', 1}, 'ó': {'o', 2}, 'ǒ': {'o', 3}, 'ò': {'o', 4},
+		'ē': {'e', 1}, 'é': {'e', 2}, 'ě': {'e', 3}, 'è': {'e', 4},
+		'ī': {'i', 1}, 'í': {'i', 2}, 'ǐ': {'i', 3}, 'ì': {'i', 4},
+		'ū': {'u', 1}, 'ú': {'u', 2}, 'ǔ': {'u', 3}, 'ù': {'u', 4},
+		'ǖ': {'v', 1}, 'ǘ': {'v', 2}, 'ǚ': {'v', 3}, 'ǜ': {'v', 4},
+		'ü': {'v', 0},
+		'ḿ': {'m', 2}, 'ń': {'n', 2}, 'ň': {'n', 3}, 'ǹ': {'n', 4},
+	}
+
+	// combiningMarks 组合用声调符号
+	combiningMarks = map[rune]int{
+		'\u0304': 1, '\u0301': 2, '\u030c': 3, '\u0300': 4,
+	}
+	combiningTones = [5]string{"", "\u0304", "\u0301", "\u030c", "\u0300"}
+
+	// toneVowels 元音及其带声调写法
+	toneVowels = map[byte][5]string{
+		'a': {"a", "ā", "á", "ǎ", "à"},
+		'o': {"o", "ō", "ó", "ǒ", "ò"},
+		'e': {"e", "ē", "é", "ě", "è"},
+		'i': {"i", "ī", "í", "ǐ", "ì"},
+		'u': {"u", "ū", "ú", "ǔ", "ù"},
+		'v': {"ü", "ǖ", "ǘ", "ǚ", "ǜ"},
+		'm': {"m", "m̄", "ḿ", "m̌", "m̀"},
+		'n': {"n", "n̄", "ń", "ň", "ǹ"},
+	}
+
+	syllableSet    = map[string]bool{}
+	maxSyllableLen = 0
+)
+
+func init() {
+	for _, s := range syllables {
+		syllableSet[s] = true
+	}
+	// j/q/x/y 后的 ü 写作 u
+	for _, s := range syllables {
+		if len(s) > 1 && strings.IndexByte("jqxy", s[0]) >= 0 && s[1] == 'u' {
+			syllableAliases[s[:1]+"v"+s[2:]] = s
+		}
+	}
+	for s := range syllableSet {
+		if len(s) > maxSyllableLen {
+			maxSyllableLen = len(s)
+		}
+	}
+	for s := range syllableAliases {
+		if len(s) > maxSyllableLen {
+			maxSyllableLen = len(s)
+		}
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+// toneStyle 声调风格
+type toneStyle int
+
+const (
+	// toneNumber 带数字的声调
+	toneNumber toneStyle = iota
+	// toneMark Unicode声调
+	toneMark
+	// toneNone 不带声调
+	toneNone
+)
+
+// Syllable 拼音音节
+type Syllable struct {
+	// Text 不带声调的音节, ü 写作 v, 如 lv, lüe 写作 lue
+	Text string
+	// Tone 声调 1-4, 0 或 5 表示轻声, 其他值按轻声输出
+	Tone int
+}
+
+// ASCII 带数字的声调
+// lv3
+func (s Syllable) ASCII(opts ...Option) string {
+	o := newOptions(opts)
+	return o.applyCase(s.format(toneNumber, o), true)
+}
+
+// Unicode Unicode声调
+// lǚ
+func (s Syllable) Unicode(opts ...Option) string {
+	o := newOptions(opts)
+	return o.applyCase(s.format(toneMark, o), true)
+}
+
+// None 不带声调输出
+// lv
+func (s Syllable) None(opts ...Option) string {
+	o := newOptions(opts)
+	return o.applyCase(s.format(toneNone, o), true)
+}
+
+// String 同 ASCII
+func (s Syllable) String() string {
+	return s.ASCII()
+}
+
+// format 按声调风格和选项输出音节
+func (s Syllable) format(style toneStyle, o *options) string {
+	var builder strings.Builder
+	s.writeTo(&builder, style, o)
+	return builder.String()
+}
+
+// writeTo 按声调风格和选项将音节写入 builder, 不处理大小写
+func (s Syllable) writeTo(builder *strings.Builder, style toneStyle, o *options) {
+	if s.Tone < 0 || s.Tone > 4 {
+		s.Tone = 0
+	}
+	text := s.Text
+	// ü 所在的位置
+	umlaut := strings.IndexByte(text, 'v')
+	if text == "lue" || text == "nue" {
+		umlaut = 1
+		text = text[:1] + "ve"
+	}
+	// 标调字母的位置
+	mark := toneIndex(text)
+	digit := ""
+	if style == toneNumber {
+		digit = o.toneDigit(s)
+	}
+	if digit != "" && (o.position == ToneFinal || mark < 0) {
+		mark = len(text)
+	}
+	v := o.v
+	if v == "" {
+		switch {
+		case style == toneMark:
+			v = VStyleUmlaut
+		case strings.IndexByte(s.Text, 'v') < 0:
+			v = VStyleU
+		default:
+			v = VStyleV
+		}
+	}
+
+	for j := 0; j < len(text); j++ {
+		switch {
+		case style == toneMark && j == umlaut && j == mark:
+			builder.WriteString(markV(v, s.Tone))
+		case j == umlaut:
+			builder.WriteString(string(v))
+		case style == toneMark && j == mark:
+			builder.WriteString(toneVowels[text[j]][s.Tone])
+		default:
+			builder.WriteByte(text[j])
+		}
+		if j == mark {
+			builder.WriteString(digit)
+		}
+	}
+	if mark == len(text) {
+		builder.WriteString(digit)
+	}
+}
+
+// markV 带声调符号的 ü
+func markV(v VStyle, tone int) string {
+	switch v {
+	case VStyleUmlaut:
+		return toneVowels['v'][tone]
+	case VStyleColon:
+		return toneVowels['u'][tone] + ":"
+	case VStyleYu:
+		return "y" + toneVowels['u'][tone]
+	case VStyleU:
+		return toneVowels['u'][tone]
+	}
+	return string(v) + combiningTones[tone]
+}
+
+// toneIndex 返回标调字母的位置, 没有可标调的字母时返回 -1
+func toneIndex(s string) int {
+	// a, e 优先, ou 标在 o 上
+	for _, c := range []string{"a", "e", "ou"} {
+		if i := strings.Index(s, c); i >= 0 {
+			return i
+		}
+	}
+	// 其余标在最后一个元音上 (iu 标在 u 上, ui 标在 i 上)
+	if i := strings.LastIndexAny(s, "iouv"); i >= 0 {
+		return i
+	}
+	// 叹词 m, n, ng, hm, hng
+	return strings.LastIndexAny(s, "mn")
+}
+
+// -----------------------------------------------------------------------------
+
+// ParseError 拼音解析错误
+type ParseError struct {
+	// Input 输入的拼音字符串
+	Input string
+	// Offset 出错位置在 Input 中的字节偏移
+	Offset int
+	// Text 无法识别的内容
+	Text string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("pinyin: invalid syllable %q at offset %d", e.Text, e.Offset)
+}
+
+// pchar 规范化后的拼音字符
+type pchar struct {
+	// c 小写字母 (ü 写作 v), 为 0 时表示声调数字
+	c byte
+	// tone 字母上的声调符号或声调数字
+	tone int
+	// off 在原字符串中的字节偏移
+	off int
+}
+
+// ParsePinyin 解析拼音字符串
+// 支持 lü4, lv4, lu:4, lǜ, LÜ, lyu, 组合用声调符号以及连写的拼音 (zhong1guo2, xi'an)
+func ParsePinyin(s string) ([]Syllable, error) {
+	var result []Syllable
+	err := scanRuns(s, func(run []pchar, end int) error {
+		syllables, _, failed := parseRun(run)
+		if syllables == nil {
+			return &ParseError{Input: s, Offset: run[failed].off, Text: s[run[0].off:end]}
+		}
+		result = append(result, syllables...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SplitSyllables 按音节表切分拼音字符串, 返回每个音节的原文
+// 支持连写的拼音 (如 None 输出的 xian, xi'an) 和儿化音 r, 无法识别为拼音的内容 (如英文单词) 整体保留
+func SplitSyllables(s string) []string {
+	var split []string
+	scanRuns(s, func(run []pchar, end int) error {
+		_, starts, _ := parseRun(run)
+		if starts == nil {
+			split = append(split, s[run[0].off:end])
+			return nil
+		}
+		for k, start := range starts {
+			e := end
+			if k+1 < len(starts) {
+				e = run[starts[k+1]].off
+			}
+			split = append(split, s[run[start].off:e])
+		}
+		return nil
+	})
+	return split
+}
+
+// scanRuns 规范化拼音字符串, 并按分隔符 (空格, 隔音符号, 标点等) 切分为连写的拼音
+// end 为连写的拼音在 s 中的结束位置
+func scanRuns(s string, fn func(run []pchar, end int) error) error {
+	var run []pchar
+
+	flush := func(end int) error {
+		if len(run) == 0 {
+			return nil
+		}
+		err := fn(run, end)
+		run = run[:0]
+		return err
+	}
+
+	for off, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+			run = append(run, pchar{c: byte(r) | 0x20, off: off})
+		case r >= '0' && r <= '9':
+			run = append(run, pchar{tone: int(r - '0'), off: off})
+		case r == ':' && len(run) > 0 && run[len(run)-1].c == 'u':
+			// u: 写法
+			run[len(run)-1].c = 'v'
+		case r == '\u0308' && len(run) > 0 && run[len(run)-1].c == 'u':
+			// 组合用分音符
+			run[len(run)-1].c = 'v'
+		case combiningMarks[r] > 0 && len(run) > 0 && run[len(run)-1].c != 0:
+			run[len(run)-1].tone = combiningMarks[r]
+		default:
+			lower := []rune(strings.ToLower(string(r)))
+			if m, ok := toneMarks[lower[0]]; ok && len(lower) == 1 {
+				run = append(run, pchar{c: byte(m[0]), tone: int(m[1]), off: off})
+				continue
+			}
+			if err := flush(off); err != nil {
+				return err
+			}
+		}
+	}
+	return flush(len(s))
+}
+
+// parseRun 将连写的拼音切分为音节, 返回音节和每个音节在 run 中的开始位置
+// 优先按隔音符号规则切分 (a, o, e 开头的音节不连写), 失败时返回 nil 和无法识别的位置
+func parseRun(run []pchar) ([]Syllable, []int, int) {
+	furthest := 0
+
+	var failed []bool
+	var parse func(i int, strict bool) ([]Syllable, []int)
+	parse = func(i int, strict bool) ([]Syllable, []int) {
+		if i == len(run) {
+			return []Syllable{}, []int{}
+		}
+		if failed[i] {
+			return nil, nil
+		}
+		if i > furthest {
+			furthest = i
+		}
+		for n := maxSyllableLen; n > 0; n-- {
+			if i+n > len(run) {
+				continue
+			}
+			syllable, ok := matchSyllable(run, i, n)
+			if !ok {
+				continue
+			}
+			// 没有声调数字隔开时, a, o, e 开头的音节应使用隔音符号, 叹词不与其他音节连写
+			if strict && i > 0 && run[i-1].c != 0 && (needsApostrophe(syllable.Text) || isInterjection(syllable.Text)) {
+				continue
+			}
+			j := i + n
+			if j < len(run) && run[j].c == 0 {
+				tone := run[j].tone
+				if tone == 5 {
+					tone = 0
+				}
+				if tone > 5 || (syllable.Tone > 0 && syllable.Tone != tone) {
+					continue
+				}
+				syllable.Tone = tone
+				j++
+			}
+			// 儿化音 r 只能跟在其他音节后面, 或单独出现
+			if syllable.Text == "r" && i == 0 && j < len(run) {
+				continue
+			}
+			if rest, starts := parse(j, strict); rest != nil {
+				return append([]Syllable{syllable}, rest...), append([]int{i}, starts...)
+			}
+		}
+		failed[i] = true
+		return nil, nil
+	}
+
+	for _, strict := range []bool{true, false} {
+		failed = make([]bool, len(run))
+		if syllables, starts := parse(0, strict); syllables != nil {
+			return syllables, starts, 0
+		}
+	}
+	return nil, nil, furthest
+}
+
+// needsApostrophe 音节与前一个音节连写时是否需要隔音符号, 即 a, o, e 开头的音节
+func needsApostrophe(syllable string) bool {
+	return syllable != "" && strings.IndexByte("aoe", syllable[0]) >= 0
+}
+
+// isInterjection 是否为叹词 m, n, ng, hm, hng
+func isInterjection(syllable string) bool {
+	switch syllable {
+	case "m", "n", "ng", "hm", "hng":
+		return true
+	}
+	return false
+}
+
+// matchSyllable 判断 run[i:i+n] 是否为合法音节
+func matchSyllable(run []pchar, i, n int) (Syllable, bool) {
+	var syllable Syllable
+	b := make([]byte, n)
+	for k := 0; k < n; k++ {
+		c := run[i+k]
+		if c.c == 0 {
+			return syllable, false
+		}
+		if c.tone > 0 {
+			if syllable.Tone > 0 {
+				return syllable, false
+			}
+			syllable.Tone = c.tone
+		}
+		b[k] = c.c
+	}
+	text := string(b)
+	if alias, ok := syllableAliases[text]; ok {
+		text = alias
+	}
+	if !syllableSet[text] {
+		return syllable, false
+	}
+	syllable.Text = text
+	return syllable, true
+}
+
+// NewConvertResultFromSyllables 由音节创建转换结果对象
+func NewConvertResultFromSyllables(syllables []Syllable, sep string) *ConvertResult {
+	m := &marked{}
+	for i, syllable := range syllables {
+		if i > 0 {
+			m.writeString(sep)
+		}
+		m.writeSyllable(syllable.ASCII(), false)
+	}
+	return m.result("", nil)
+}
+
+// tokenSyllable 将 ASCII 格式的单个音节 (如 lv3) 转换为 Syllable
+func tokenSyllable(token string) (Syllable, bool) {
+	if token == "" {
+		return Syllable{}, false
+	}
+	tone := 0
+	text := token
+	if last := token[len(token)-1]; last >= '1' && last <= '4' {
+		tone = int(last - '0')
+		text = token[:len(token)-1]
+	}
+	if alias, ok := syllableAliases[text]; ok {
+		text = alias
+	}
+	if !syllableSet[text] {
+		return Syllable{}, false
+	}
+	return Syllable{Text: text, Tone: tone}, true
+}
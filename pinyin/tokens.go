@@ -0,0 +1,28 @@
+package pinyin
+
+// Token 分词结果
+type Token struct {
+	// Text 原文, 全角字符和数字已按词典的设置处理
+	Text string
+	// Syllables 拼音音节, 不是汉字的内容为 nil
+	Syllables []Syllable
+}
+
+// Tokens 按专有名词和词典分词, 返回每个词语及其拼音, 其他内容按原样返回
+// 我爱北京天安门 → 我 [wo3], 爱 [ai4], 北京 [bei3 jing1], 天安门 [tian1 an1 men2]
+func (p *Dict) Tokens(s string) (result []Token) {
+	// 与 prepare 不同, 保留字母, 数字和符号的原文
+	if !p.keepWidth {
+		s = foldWidth(s)
+	}
+	for _, w := range p.segmentWords(p.verbalizeNumbers(s)) {
+		token := Token{Text: w.text}
+		for _, syllable := range w.syllables {
+			if syl, ok := tokenSyllable(syllable); ok {
+				token.Syllables = append(token.Syllables, syl)
+			}
+		}
+		result = append(result, token)
+	}
+	return
+}
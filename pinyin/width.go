@@ -0,0 +1,62 @@
+package pinyin
+
+import "strings"
+
+var (
+	// halfWidthKatakana 半角片假名和标点对应的全角字符, 带浊音符号的排在前面
+	halfWidthKatakana = []string{
+		"ｦﾞ", "ヺ", "ｳﾞ", "ヴ", "ｶﾞ", "ガ", "ｷﾞ", "ギ", "ｸﾞ", "グ", "ｹﾞ", "ゲ", "ｺﾞ", "ゴ", "ｻﾞ", "ザ",
+		"ｼﾞ", "ジ", "ｽﾞ", "ズ", "ｾﾞ", "ゼ", "ｿﾞ", "ゾ", "ﾀﾞ", "ダ", "ﾁﾞ", "ヂ", "ﾂﾞ", "ヅ", "ﾃﾞ", "デ",
+		"ﾄﾞ", "ド", "ﾊﾞ", "バ", "ﾊﾟ", "パ", "ﾋﾞ", "ビ", "ﾋﾟ", "ピ", "ﾌﾞ", "ブ", "ﾌﾟ", "プ", "ﾍﾞ", "ベ",
+		"ﾍﾟ", "ペ", "ﾎﾞ", "ボ", "ﾎﾟ", "ポ", "ﾜﾞ", "ヷ", "｡", "。", "｢", "「", "｣", "」", "､", "、",
+		"･", "・", "ｦ", "ヲ", "ｧ", "ァ", "ｨ", "ィ", "ｩ", "ゥ", "ｪ", "ェ", "ｫ", "ォ", "ｬ", "ャ",
+		"ｭ", "ュ", "ｮ", "ョ", "ｯ", "ッ", "ｰ", "ー", "ｱ", "ア", "ｲ", "イ", "ｳ", "ウ", "ｴ", "エ",
+		"ｵ", "オ", "ｶ", "カ", "ｷ", "キ", "ｸ", "ク", "ｹ", "ケ", "ｺ", "コ", "ｻ", "サ", "ｼ", "シ",
+		"ｽ", "ス", "ｾ", "セ", "ｿ", "ソ", "ﾀ", "タ", "ﾁ", "チ", "ﾂ", "ツ", "ﾃ", "テ", "ﾄ", "ト",
+		"ﾅ", "ナ", "ﾆ", "ニ", "ﾇ", "ヌ", "ﾈ", "ネ", "ﾉ", "ノ", "ﾊ", "ハ", "ﾋ", "ヒ", "ﾌ", "フ",
+		"ﾍ", "ヘ", "ﾎ", "ホ", "ﾏ", "マ", "ﾐ", "ミ", "ﾑ", "ム", "ﾒ", "メ", "ﾓ", "モ", "ﾔ", "ヤ",
+		"ﾕ", "ユ", "ﾖ", "ヨ", "ﾗ", "ラ", "ﾘ", "リ", "ﾙ", "ル", "ﾚ", "レ", "ﾛ", "ロ", "ﾜ", "ワ",
+		"ﾝ", "ン", "ﾞ", "゙", "ﾟ", "゚",
+	}
+
+	// katakanaReplacer 半角片假名转换为全角
+	katakanaReplacer = strings.NewReplacer(halfWidthKatakana...)
+
+	// fullWidthPunctuations 在 punctuations 中的全角符号, 不转换为半角
+	fullWidthPunctuations = map[rune]bool{}
+)
+
+func init() {
+	for i := 0; i < len(punctuations); i += 2 {
+		if r := []rune(punctuations[i]); len(r) == 1 && r[0] >= 0xFF01 && r[0] <= 0xFF5E {
+			fullWidthPunctuations[r[0]] = true
+		}
+	}
+}
+
+// SetKeepWidth 是否保留全角字母, 数字, 全角空格和半角片假名, 默认转换为半角和全角片假名
+// 不能与转换同时调用
+func (p *Dict) SetKeepWidth(keep bool) {
+	p.keepWidth = keep
+}
+
+// foldWidth 全角字母, 数字和符号转换为半角, 全角空格转换为空格, 半角片假名转换为全角
+// ＡＢＣ１２３ → ABC123, 中文标点 (如 ，！) 保持不变
+func foldWidth(s string) string {
+	katakana := false
+	s = strings.Map(func(r rune) rune {
+		switch {
+		case r == '　':
+			return ' '
+		case r >= 0xFF01 && r <= 0xFF5E && !fullWidthPunctuations[r]:
+			return r - 0xFEE0
+		case r >= 0xFF61 && r <= 0xFF9F:
+			katakana = true
+		}
+		return r
+	}, s)
+	if !katakana {
+		return s
+	}
+	return katakanaReplacer.Replace(s)
+}
@@ -0,0 +1,117 @@
+package pinyin
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+var (
+	charReadingsOnce sync.Once
+	// charReadings 单字的所有读音, 单字词条的读音在前, 其余读音按词典中词语的顺序, 第一次使用时生成
+	charReadings map[rune][]string
+)
+
+// loadCharReadings 由内置词典生成 charReadings
+func loadCharReadings() {
+	charReadings = map[rune][]string{}
+	entries := builtinDict().Entries()
+	for _, e := range entries {
+		if utf8.RuneCountInString(e.Word) == 1 && len(e.Syllables) == 1 {
+			addCharReading([]rune(e.Word)[0], e.Syllables[0])
+		}
+	}
+	// 词语中的多音字, 字数与音节数相同时逐字对应
+	for _, e := range entries {
+		runes := []rune(e.Word)
+		if len(runes) == 1 || len(runes) != len(e.Syllables) {
+			continue
+		}
+		for k, r := range runes {
+			if _, ok := charReadings[r]; ok {
+				addCharReading(r, e.Syllables[k])
+			}
+		}
+	}
+}
+
+// addCharReading 添加单字的读音, 忽略重复和不合法的读音
+func addCharReading(r rune, reading string) {
+	if _, ok := tokenSyllable(reading); !ok || containsString(charReadings[r], reading) {
+		return
+	}
+	charReadings[r] = append(charReadings[r], reading)
+}
+
+// AddWords 添加词语的读音, 优先于内置的词典, 如 重庆 → chong2 qing4
+// 读音为带数字声调的音节, 以空格分隔, 不能与转换同时调用
+func (p *Dict) AddWords(words map[string]string) {
+	if p.words == nil {
+		p.words = newTable(nil)
+	}
+	for word, reading := range words {
+		p.words.add(word, reading)
+	}
+}
+
+// LoadWords 读取用户词典并添加到词典中
+// 每行一个词语, 词语和读音以空白分隔, 如 "重庆 chong2 qing4", 忽略空行和 # 开头的注释
+func (p *Dict) LoadWords(r io.Reader) error {
+	words := map[string]string{}
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		if len(fields) == 1 {
+			return fmt.Errorf("pinyin: line %d: missing reading for %q", line, fields[0])
+		}
+		for _, syllable := range fields[1:] {
+			if _, ok := tokenSyllable(syllable); !ok {
+				return fmt.Errorf("pinyin: line %d: invalid syllable %q", line, syllable)
+			}
+		}
+		if n, m := utf8.RuneCountInString(fields[0]), len(fields)-1; n != m {
+			return fmt.Errorf("pinyin: line %d: %q has %d characters but %d syllables", line, fields[0], n, m)
+		}
+		words[fields[0]] = strings.Join(fields[1:], " ")
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	p.AddWords(words)
+	return nil
+}
+
+// Heteronyms 返回每个汉字的所有读音, 不在词典中的字符被忽略
+// 中国 → [[zhong1 zhong4] [guo2]]
+func (p *Dict) Heteronyms(s string) (result [][]Syllable) {
+	charReadingsOnce.Do(loadCharReadings)
+	for _, r := range s {
+		readings, ok := charReadings[r]
+		if !ok {
+			continue
+		}
+		syllables := make([]Syllable, 0, len(readings))
+		for _, reading := range readings {
+			syllable, _ := tokenSyllable(reading)
+			syllables = append(syllables, syllable)
+		}
+		result = append(result, syllables)
+	}
+	return
+}
+
+// containsString 字符串数组中是否包含 s
+func containsString(a []string, s string) bool {
+	for _, item := range a {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}